@@ -0,0 +1,108 @@
+package dhl
+
+import (
+	"fmt"
+	"slices"
+)
+
+// ServiceAddon is a DHL24 special service code sent in shipmentInfo.specialServices
+type ServiceAddon string
+
+// DHL24 service add-ons
+const (
+	AddonSaturdayDelivery   ServiceAddon = "SOBOTA"     // delivery on Saturday
+	AddonSaturdayPickup     ServiceAddon = "NAD_SOBOTA" // pickup on Saturday
+	AddonEveningDelivery    ServiceAddon = "1722"       // delivery between 17:00 and 22:00
+	AddonInsurance          ServiceAddon = "UBEZP"      // insurance, ServiceValue is the insured amount
+	AddonCOD                ServiceAddon = "COD"        // cash on delivery, ServiceValue is the amount, see ShipmentBuilder.WithCOD
+	AddonInfoBeforeDelivery ServiceAddon = "PDI"        // receiver notification before delivery
+	AddonReturnOfDocuments  ServiceAddon = "ROD"        // return of signed documents
+	AddonProofOfDelivery    ServiceAddon = "POD"        // proof of delivery
+	AddonNeighbourDelivery  ServiceAddon = "SAS"        // delivery to a neighbour
+	AddonSelfPickup         ServiceAddon = "ODB"        // pickup by the receiver at a DHL terminal
+)
+
+// codFormBankTransfer is the collectOnDeliveryForm of COD amounts paid by the receiver with a bank transfer
+const codFormBankTransfer = "BANK_TRANSFER"
+
+// addonProducts lists the products each add-on can be ordered with, add-ons not listed are available for all products
+var addonProducts = map[ServiceAddon][]ProductCode{
+	AddonSaturdayDelivery:   {ProductParcel, ProductExpress9, ProductExpress12},
+	AddonSaturdayPickup:     {ProductParcel, ProductExpress9, ProductExpress12, ProductEvening},
+	AddonEveningDelivery:    {ProductParcel},
+	AddonInfoBeforeDelivery: {ProductParcel, ProductExpress9, ProductExpress12, ProductEvening, ProductServicePoint},
+	AddonReturnOfDocuments:  {ProductParcel, ProductExpress9, ProductExpress12, ProductEvening, ProductPremium},
+	AddonProofOfDelivery:    {ProductParcel, ProductExpress9, ProductExpress12, ProductEvening, ProductPremium},
+	AddonNeighbourDelivery:  {ProductParcel, ProductExpress9, ProductExpress12, ProductEvening},
+	AddonSelfPickup:         {ProductParcel},
+}
+
+// valueAddons need a ServiceValue and cannot be ordered with ShipmentItem.ServiceAddons
+var valueAddons = []ServiceAddon{AddonInsurance, AddonCOD}
+
+// IsValid reports whether the add-on is a documented DHL24 special service
+func (a ServiceAddon) IsValid() bool {
+	_, ok := addonProducts[a]
+	return ok || slices.Contains(valueAddons, a)
+}
+
+// AvailableFor reports whether the add-on can be ordered with the product
+func (a ServiceAddon) AvailableFor(product ProductCode) bool {
+	products, ok := addonProducts[a]
+	return !ok || slices.Contains(products, product)
+}
+
+// withServiceAddons returns the item with ServiceAddons merged into shipmentInfo.specialServices
+// Add-ons already present in SpecialServices are not repeated, the original item is not modified
+func (s ShipmentItem) withServiceAddons() ShipmentItem {
+	if len(s.ServiceAddons) == 0 {
+		return s
+	}
+
+	info := ShipmentInfo{}
+	if s.ShipmentInfo != nil {
+		info = *s.ShipmentInfo
+	}
+	services := SpecialServices{}
+	if info.SpecialServices != nil {
+		services.Items = slices.Clone(info.SpecialServices.Items)
+	}
+
+	for _, addon := range s.ServiceAddons {
+		if !slices.ContainsFunc(services.Items, func(item SpecialService) bool { return item.ServiceType == addon }) {
+			services.Items = append(services.Items, SpecialService{ServiceType: addon})
+		}
+	}
+
+	info.SpecialServices = &services
+	s.ShipmentInfo = &info
+	return s
+}
+
+// addonFieldErrors checks that add-ons are known and available for the shipment product
+func (s ShipmentItem) addonFieldErrors() ValidationErrors {
+	var errs ValidationErrors
+	for i, addon := range s.ServiceAddons {
+		field := fmt.Sprintf("serviceAddons[%d]", i)
+		switch {
+		case !addon.IsValid():
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("unknown add-on %q", addon)})
+		case slices.Contains(valueAddons, addon):
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("add-on %s needs a value, set it in shipmentInfo.specialServices", addon)})
+		case !addon.AvailableFor(s.Service.Product):
+			errs = append(errs, FieldError{Field: field, Message: fmt.Sprintf("add-on %s is not available for product %s", addon, s.Service.Product)})
+		}
+	}
+
+	if s.ShipmentInfo != nil && s.ShipmentInfo.SpecialServices != nil {
+		for i, service := range s.ShipmentInfo.SpecialServices.Items {
+			if !service.ServiceType.AvailableFor(s.Service.Product) {
+				errs = append(errs, FieldError{
+					Field:   fmt.Sprintf("shipmentInfo.specialServices.item[%d].serviceType", i),
+					Message: fmt.Sprintf("add-on %s is not available for product %s", service.ServiceType, s.Service.Product),
+				})
+			}
+		}
+	}
+	return errs
+}
@@ -0,0 +1,46 @@
+package dhl
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestWithServiceAddonsMergesSpecialServices(t *testing.T) {
+	item := validShipment()
+	item.ShipmentInfo = &ShipmentInfo{SpecialServices: &SpecialServices{Items: []SpecialService{
+		{ServiceType: AddonInsurance, ServiceValue: "500"},
+		{ServiceType: AddonSaturdayDelivery},
+	}}}
+	item.ServiceAddons = []ServiceAddon{AddonSaturdayDelivery, AddonProofOfDelivery}
+
+	merged := item.withServiceAddons()
+
+	got := merged.ShipmentInfo.SpecialServices.Items
+	if len(got) != 3 || got[0].ServiceValue != "500" || got[2].ServiceType != AddonProofOfDelivery {
+		t.Errorf("unexpected special services: %+v", got)
+	}
+	if len(item.ShipmentInfo.SpecialServices.Items) != 2 {
+		t.Error("withServiceAddons modified the original item")
+	}
+}
+
+func TestValidateServiceAddons(t *testing.T) {
+	item := validShipment()
+	item.Service.Product = ProductExpress9
+	item.ServiceAddons = []ServiceAddon{AddonSaturdayDelivery, AddonEveningDelivery, AddonInsurance, "XYZ"}
+
+	var errs ValidationErrors
+	if !errors.As(item.Validate(), &errs) {
+		t.Fatalf("expected ValidationErrors, got %v", item.Validate())
+	}
+
+	want := []string{"serviceAddons[1]", "serviceAddons[2]", "serviceAddons[3]"}
+	if len(errs) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for i, field := range want {
+		if errs[i].Field != field {
+			t.Errorf("error %d field = %q, want %q", i, errs[i].Field, field)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package dhl
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DefaultCountry is the country assumed when Address.Country is empty
+const DefaultCountry CountryCode = "PL"
+
+// houseNumberPattern splits "Street 10/5" into street, house number and apartment number
+var houseNumberPattern = regexp.MustCompile(`^(.*\S)\s+(\d+[A-Za-z]?)(?:/(\w+))?$`)
+
+// polishPostalCodePattern matches Polish postal codes with or without the dash
+var polishPostalCodePattern = regexp.MustCompile(`^\d{2}-?\d{3}$`)
+
+// EffectiveCountry returns the address country, or DefaultCountry when it is not set
+func (a Address) EffectiveCountry() CountryCode {
+	if a.Country == "" {
+		return DefaultCountry
+	}
+	return CountryCode(a.Country)
+}
+
+// Validate checks that required address fields are set
+// An empty Country is treated as DefaultCountry, Polish postal codes must be in NN-NNN or NNNNN format
+func (a Address) Validate() error {
+	var errs []error
+	required := []struct {
+		name  string
+		value string
+	}{
+		{"name", a.Name},
+		{"postalCode", a.PostalCode},
+		{"city", a.City},
+		{"street", a.Street},
+		{"houseNumber", a.HouseNumber},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			errs = append(errs, fmt.Errorf("%s is required", field.name))
+		}
+	}
+
+	if a.PostalCode != "" && a.EffectiveCountry() == "PL" && !polishPostalCodePattern.MatchString(a.PostalCode) {
+		errs = append(errs, fmt.Errorf("invalid Polish postal code %q", a.PostalCode))
+	}
+
+	return errors.Join(errs...)
+}
+
+// addressXML wraps Address with a root element for standalone serialization
+type addressXML struct {
+	XMLName xml.Name `xml:"address"`
+	Address
+}
+
+// ToXML serializes the address as a standalone <address> element using the DHL24 AddressData schema
+func (a Address) ToXML() ([]byte, error) {
+	data, err := xml.Marshal(addressXML{Address: a})
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling address: %w", err)
+	}
+	return data, nil
+}
+
+// ParseAddress parses an address from XML, the root element name is not checked
+func ParseAddress(xmlBytes []byte) (Address, error) {
+	var wrapper addressXML
+	if err := xml.Unmarshal(xmlBytes, &wrapper); err != nil {
+		return Address{}, fmt.Errorf("error parsing address: %w", err)
+	}
+	return wrapper.Address, nil
+}
+
+// ToJSON serializes the address to JSON
+func (a Address) ToJSON() ([]byte, error) {
+	data, err := json.Marshal(a)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling address: %w", err)
+	}
+	return data, nil
+}
+
+// ParseAddressFromJSON parses an address from JSON
+func ParseAddressFromJSON(b []byte) (Address, error) {
+	var address Address
+	if err := json.Unmarshal(b, &address); err != nil {
+		return Address{}, fmt.Errorf("error parsing address: %w", err)
+	}
+	return address, nil
+}
+
+// AddressFromVCard populates an address from a single vCard
+// FN maps to Name, ADR to Street/HouseNumber/ApartmentNumber/City/State/PostalCode/Country, TEL and EMAIL to the contact
+// Only two-letter ADR country values are used, anything else leaves Country empty
+func AddressFromVCard(vcard string) (Address, error) {
+	var address Address
+	var found bool
+	for _, line := range unfoldVCard(vcard) {
+		name, value, ok := strings.Cut(line, ":")
+		if !ok {
+			continue
+		}
+		name, _, _ = strings.Cut(name, ";")
+		if _, after, grouped := strings.Cut(name, "."); grouped {
+			name = after
+		}
+		switch strings.ToUpper(name) {
+		case "BEGIN":
+			found = true
+		case "FN":
+			address.Name = unescapeVCard(value)
+		case "ADR":
+			parts := splitVCard(value)
+			for len(parts) < 7 {
+				parts = append(parts, "")
+			}
+			address.Street = parts[2]
+			if m := houseNumberPattern.FindStringSubmatch(parts[2]); m != nil {
+				address.Street, address.HouseNumber, address.ApartmentNumber = m[1], m[2], m[3]
+			}
+			address.City = parts[3]
+			address.State = parts[4]
+			address.PostalCode = parts[5]
+			if len(parts[6]) == 2 {
+				address.Country = strings.ToUpper(parts[6])
+			}
+		case "TEL":
+			if address.ContactPhone == "" {
+				address.ContactPhone = strings.TrimPrefix(unescapeVCard(value), "tel:")
+			}
+		case "EMAIL":
+			if address.ContactEmail == "" {
+				address.ContactEmail = unescapeVCard(value)
+			}
+		}
+	}
+	if !found {
+		return Address{}, fmt.Errorf("error parsing vCard: missing BEGIN:VCARD")
+	}
+	return address, nil
+}
+
+// ToVCard serializes the address as a vCard 3.0
+func (a Address) ToVCard() string {
+	street := a.Street
+	if a.HouseNumber != "" {
+		street += " " + a.HouseNumber
+		if a.ApartmentNumber != "" {
+			street += "/" + a.ApartmentNumber
+		}
+	}
+	var b strings.Builder
+	b.WriteString("BEGIN:VCARD\r\nVERSION:3.0\r\n")
+	fmt.Fprintf(&b, "FN:%s\r\n", escapeVCard(a.Name))
+	fmt.Fprintf(&b, "ADR:;;%s;%s;%s;%s;%s\r\n", escapeVCard(street), escapeVCard(a.City), escapeVCard(a.State), escapeVCard(a.PostalCode), escapeVCard(a.Country))
+	if a.ContactPhone != "" {
+		fmt.Fprintf(&b, "TEL:%s\r\n", escapeVCard(a.ContactPhone))
+	}
+	if a.ContactEmail != "" {
+		fmt.Fprintf(&b, "EMAIL:%s\r\n", escapeVCard(a.ContactEmail))
+	}
+	b.WriteString("END:VCARD\r\n")
+	return b.String()
+}
+
+// unfoldVCard joins folded continuation lines and drops empty ones
+func unfoldVCard(vcard string) []string {
+	var lines []string
+	for _, line := range strings.Split(strings.ReplaceAll(vcard, "\r\n", "\n"), "\n") {
+		if (strings.HasPrefix(line, " ") || strings.HasPrefix(line, "\t")) && len(lines) > 0 {
+			lines[len(lines)-1] += line[1:]
+			continue
+		}
+		if line != "" {
+			lines = append(lines, line)
+		}
+	}
+	return lines
+}
+
+// splitVCard splits a structured value on unescaped semicolons and unescapes each component
+func splitVCard(value string) []string {
+	var parts []string
+	var current strings.Builder
+	for i := 0; i < len(value); i++ {
+		switch {
+		case value[i] == '\\' && i+1 < len(value):
+			current.WriteByte('\\')
+			current.WriteByte(value[i+1])
+			i++
+		case value[i] == ';':
+			parts = append(parts, unescapeVCard(current.String()))
+			current.Reset()
+		default:
+			current.WriteByte(value[i])
+		}
+	}
+	return append(parts, unescapeVCard(current.String()))
+}
+
+var (
+	vcardEscaper   = strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\n", `\n`)
+	vcardUnescaper = strings.NewReplacer(`\\`, `\`, `\;`, ";", `\,`, ",", `\n`, "\n", `\N`, "\n")
+)
+
+func escapeVCard(s string) string {
+	return vcardEscaper.Replace(s)
+}
+
+func unescapeVCard(s string) string {
+	return strings.TrimSpace(vcardUnescaper.Replace(s))
+}
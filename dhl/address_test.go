@@ -0,0 +1,77 @@
+package dhl
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestAddressEffectiveCountry(t *testing.T) {
+	if got := (Address{}).EffectiveCountry(); got != "PL" {
+		t.Errorf("EffectiveCountry() = %q, want PL", got)
+	}
+	if got := (Address{Country: "DE"}).EffectiveCountry(); got != "DE" {
+		t.Errorf("EffectiveCountry() = %q, want DE", got)
+	}
+}
+
+func TestCreateShipmentsDefaultsReceiverCountry(t *testing.T) {
+	var requestBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requestBody = string(body)
+		io.WriteString(w, `<Envelope><Body><createShipmentsResponse><createShipmentsResult><item><shipmentId>1</shipmentId></item></createShipmentsResult></createShipmentsResponse></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{})
+	client.endpoint = server.URL
+
+	_, _, err := client.CreateShipments(context.Background(), []ShipmentItem{{Receiver: Address{Name: "Receiver"}}})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+
+	if !strings.Contains(requestBody, "<country>PL</country>") {
+		t.Errorf("expected receiver country PL in request:\n%s", requestBody)
+	}
+}
+
+func TestAddressFromVCard(t *testing.T) {
+	vcard := "BEGIN:VCARD\r\nVERSION:3.0\r\nFN:Jan Kowalski\r\nADR;TYPE=work:;;Marszałkowska 10/5;Warszawa;;00-001;PL\r\nTEL;TYPE=cell:+48 600 100 200\r\nEMAIL:jan@example.com\r\nEND:VCARD\r\n"
+	address, err := AddressFromVCard(vcard)
+	if err != nil {
+		t.Fatalf("parse: %v", err)
+	}
+	want := Address{Country: "PL", Name: "Jan Kowalski", PostalCode: "00-001", City: "Warszawa", Street: "Marszałkowska", HouseNumber: "10", ApartmentNumber: "5",
+		ContactPhone: "+48 600 100 200", ContactEmail: "jan@example.com"}
+	if address != want {
+		t.Errorf("AddressFromVCard() = %+v, want %+v", address, want)
+	}
+
+	roundTrip, err := AddressFromVCard(address.ToVCard())
+	if err != nil {
+		t.Fatalf("parse round trip: %v", err)
+	}
+	if roundTrip != want {
+		t.Errorf("round trip = %+v, want %+v", roundTrip, want)
+	}
+}
+
+func TestAddressBookImportFromVCard(t *testing.T) {
+	vcf := Address{Name: "A; Sp. z o.o."}.ToVCard() + Address{Name: "B", City: "Kraków"}.ToVCard()
+	book := NewAddressBook()
+	n, err := book.ImportFromVCard(strings.NewReader(vcf))
+	if err != nil {
+		t.Fatalf("import: %v", err)
+	}
+	if n != 2 || book.Len() != 2 {
+		t.Fatalf("imported %d, book has %d, want 2", n, book.Len())
+	}
+	if _, ok := book.Get("A; Sp. z o.o."); !ok {
+		t.Errorf("escaped name not imported")
+	}
+}
@@ -0,0 +1,114 @@
+package dhl
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// AddressBook is an in-memory collection of addresses keyed by name
+type AddressBook struct {
+	mu        sync.RWMutex
+	addresses map[string]Address
+}
+
+// NewAddressBook returns an empty address book
+func NewAddressBook() *AddressBook {
+	return &AddressBook{addresses: make(map[string]Address)}
+}
+
+// Add stores an address, replacing any existing address with the same name
+func (b *AddressBook) Add(address Address) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.addresses[address.Name] = address
+}
+
+// Get returns the address stored under name
+func (b *AddressBook) Get(name string) (Address, bool) {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	address, ok := b.addresses[name]
+	return address, ok
+}
+
+// Remove deletes the address stored under name
+func (b *AddressBook) Remove(name string) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	delete(b.addresses, name)
+}
+
+// Search returns addresses whose name, city, street or postal code contains query, ignoring case
+func (b *AddressBook) Search(query string) []Address {
+	var found []Address
+	for _, address := range b.List() {
+		if address.matches(query) {
+			found = append(found, address)
+		}
+	}
+	return found
+}
+
+// matches reports whether any searchable field contains query, ignoring case
+func (a Address) matches(query string) bool {
+	query = strings.ToLower(query)
+	for _, field := range []string{a.Name, a.City, a.Street, a.PostalCode} {
+		if strings.Contains(strings.ToLower(field), query) {
+			return true
+		}
+	}
+	return false
+}
+
+// List returns all addresses sorted by name
+func (b *AddressBook) List() []Address {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	list := make([]Address, 0, len(b.addresses))
+	for _, address := range b.addresses {
+		list = append(list, address)
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i].Name < list[j].Name })
+	return list
+}
+
+// Len returns the number of stored addresses
+func (b *AddressBook) Len() int {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	return len(b.addresses)
+}
+
+// ImportFromVCard adds every vCard found in vcf and returns the number of imported addresses
+// Cards without a name are skipped
+func (b *AddressBook) ImportFromVCard(vcf io.Reader) (int, error) {
+	var imported int
+	var card strings.Builder
+	scanner := bufio.NewScanner(vcf)
+	for scanner.Scan() {
+		line := scanner.Text()
+		card.WriteString(line)
+		card.WriteString("\n")
+		if !strings.EqualFold(strings.TrimSpace(line), "END:VCARD") {
+			continue
+		}
+		address, err := AddressFromVCard(card.String())
+		card.Reset()
+		if err != nil {
+			return imported, err
+		}
+		if address.Name == "" {
+			continue
+		}
+		b.Add(address)
+		imported++
+	}
+	if err := scanner.Err(); err != nil {
+		return imported, fmt.Errorf("error reading vCard: %w", err)
+	}
+	return imported, nil
+}
@@ -0,0 +1,66 @@
+package dhl
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// FaultHandler is called when an operation finishes with a SOAP fault
+type FaultHandler func(faultCode int, operation string, err *SOAPFault)
+
+// WithFaultCodeAlertHook registers a handler called synchronously after every SOAP fault
+// The handler is called once per operation, after all retry attempts, and does not affect the returned error
+func WithFaultCodeAlertHook(handler FaultHandler) Option {
+	return func(c *Client) {
+		c.faultHandlers = append(c.faultHandlers, handler)
+	}
+}
+
+// WithAlertOnFaultCodes registers a handler called only for the specified fault codes
+func WithAlertOnFaultCodes(codes []int, handler FaultHandler) Option {
+	return WithFaultCodeAlertHook(func(faultCode int, operation string, err *SOAPFault) {
+		if slices.Contains(codes, faultCode) {
+			handler(faultCode, operation, err)
+		}
+	})
+}
+
+// notifyFault calls all registered fault handlers
+func (c *Client) notifyFault(operation string, fault *SOAPFault) {
+	for _, handler := range c.faultHandlers {
+		handler(fault.FaultCode(), operation, fault)
+	}
+}
+
+// NewSlackAlertHook returns a FaultHandler that posts a message to a Slack incoming webhook
+// Delivery failures are logged as warnings to logger, if not nil, and never affect the API call
+func NewSlackAlertHook(webhookURL string, logger Logger) FaultHandler {
+	if logger == nil {
+		logger = noopLogger{}
+	}
+	httpClient := &http.Client{Timeout: 5 * time.Second}
+
+	return func(faultCode int, operation string, err *SOAPFault) {
+		payload, marshalErr := json.Marshal(map[string]string{
+			"text": fmt.Sprintf("DHL24 %s failed with fault %d: %s", operation, faultCode, err.Message),
+		})
+		if marshalErr != nil {
+			return
+		}
+
+		resp, postErr := httpClient.Post(webhookURL, "application/json", bytes.NewReader(payload))
+		if postErr != nil {
+			logger.Warn("failed to send Slack alert", "error", postErr)
+			return
+		}
+		resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			logger.Warn("Slack alert returned unexpected status", "status", resp.Status)
+		}
+	}
+}
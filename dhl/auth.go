@@ -0,0 +1,126 @@
+package dhl
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"regexp"
+)
+
+// ErrSessionExpired indicates that the API rejected the request because the session or token expired
+// A *SOAPFault with code 401 matches this error via errors.Is
+var ErrSessionExpired = errors.New("session expired")
+
+// faultCodeSessionExpired is the SOAP fault code reported for expired sessions
+const faultCodeSessionExpired = 401
+
+// CredentialProvider supplies API credentials, e.g. from a secret store that rotates them
+type CredentialProvider interface {
+	Username() string
+	Password() string
+}
+
+// WithAutoRefreshCredentials takes credentials from the provider for every request
+// When ErrSessionExpired is received, the request is retried once with fresh credentials
+func WithAutoRefreshCredentials(provider CredentialProvider) Option {
+	return func(c *Client) {
+		c.credentials = provider
+		c.httpClient.Transport = &AuthenticatedTransport{
+			Base:     c.httpClient.Transport,
+			Provider: provider,
+		}
+	}
+}
+
+var (
+	usernamePattern = regexp.MustCompile(`<username>[^<]*</username>`)
+	passwordPattern = regexp.MustCompile(`<password>[^<]*</password>`)
+)
+
+// AuthenticatedTransport is an http.RoundTripper that injects credentials into SOAP request bodies
+// and transparently retries once with fresh credentials when the session has expired
+type AuthenticatedTransport struct {
+	// Base is the underlying transport, http.DefaultTransport is used when nil
+	Base http.RoundTripper
+	// Provider supplies current credentials
+	Provider CredentialProvider
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *AuthenticatedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	var body []byte
+	if req.Body != nil {
+		var err error
+		body, err = io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			return nil, fmt.Errorf("error reading request body: %w", err)
+		}
+	}
+
+	resp, err := t.send(req, body)
+	if err != nil || !isSessionExpired(resp) {
+		return resp, err
+	}
+	resp.Body.Close()
+
+	return t.send(req, body)
+}
+
+// send injects current credentials into the body and performs the request
+func (t *AuthenticatedTransport) send(req *http.Request, body []byte) (*http.Response, error) {
+	authBody := injectCredentials(body, t.Provider.Username(), t.Provider.Password())
+
+	outReq := req.Clone(req.Context())
+	outReq.Body = io.NopCloser(bytes.NewReader(authBody))
+	outReq.ContentLength = int64(len(authBody))
+
+	return t.base().RoundTrip(outReq)
+}
+
+// base returns the underlying transport
+func (t *AuthenticatedTransport) base() http.RoundTripper {
+	if t.Base != nil {
+		return t.Base
+	}
+	return http.DefaultTransport
+}
+
+// injectCredentials replaces username and password elements in a SOAP request body
+func injectCredentials(body []byte, username, password string) []byte {
+	body = usernamePattern.ReplaceAllLiteral(body, []byte("<username>"+escapeXML(username)+"</username>"))
+	return passwordPattern.ReplaceAllLiteral(body, []byte("<password>"+escapeXML(password)+"</password>"))
+}
+
+// redactPassword hides the password element of a SOAP request body, e.g. before logging it
+func redactPassword(body []byte) []byte {
+	return passwordPattern.ReplaceAllLiteral(body, []byte("<password>***</password>"))
+}
+
+// escapeXML escapes text for use inside an XML element
+func escapeXML(s string) string {
+	var buf bytes.Buffer
+	_ = xml.EscapeText(&buf, []byte(s))
+	return buf.String()
+}
+
+// isSessionExpired reports whether the response signals an expired session
+// The response body is restored so it can still be read by the caller
+func isSessionExpired(resp *http.Response) bool {
+	if resp.StatusCode == http.StatusUnauthorized {
+		return true
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	resp.Body = io.NopCloser(bytes.NewReader(body))
+	if err != nil {
+		return false
+	}
+
+	fault := parseSOAPFault(body)
+	return fault != nil && fault.FaultCode() == faultCodeSessionExpired
+}
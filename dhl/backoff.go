@@ -0,0 +1,99 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"math/rand/v2"
+	"time"
+)
+
+// faultCodeServiceUnavailable is the SOAP fault code reported while DHL24 is overloaded or in maintenance
+const faultCodeServiceUnavailable = 503
+
+// jitterMode selects how randomness is applied to a backoff delay
+type jitterMode int
+
+const (
+	fullJitter jitterMode = iota
+	equalJitter
+)
+
+// ExponentialBackoff computes retry delays of base * 2^attempt, capped at Cap
+// Full jitter is the default, delay = random(0, min(Cap, Base*2^attempt))
+// It spreads retries of many clients over the whole window and, per the AWS analysis of backoff algorithms,
+// needs the fewest calls to drain a thundering herd, equal jitter always waits at least half of the delay
+type ExponentialBackoff struct {
+	Base time.Duration
+	Cap  time.Duration
+	mode jitterMode
+}
+
+// FullJitter returns a copy of the backoff using delay = random(0, d)
+func (b ExponentialBackoff) FullJitter() ExponentialBackoff {
+	b.mode = fullJitter
+	return b
+}
+
+// EqualJitter returns a copy of the backoff using delay = d/2 + random(0, d/2)
+func (b ExponentialBackoff) EqualJitter() ExponentialBackoff {
+	b.mode = equalJitter
+	return b
+}
+
+// Delay returns the randomized delay before retry number attempt, starting at 0
+func (b ExponentialBackoff) Delay(attempt int) time.Duration {
+	d := b.Base
+	for i := 0; i < attempt && (b.Cap <= 0 || d < b.Cap); i++ {
+		d *= 2
+	}
+	if b.Cap > 0 && d > b.Cap {
+		d = b.Cap
+	}
+	if d <= 0 {
+		return 0
+	}
+
+	if b.mode == equalJitter {
+		half := d / 2
+		return half + rand.N(d-half+1)
+	}
+	return rand.N(d + 1)
+}
+
+// WithFaultRetry retries requests failing with SOAP fault 503 up to maxAttempts times in total,
+// waiting backoff.Delay between attempts
+func WithFaultRetry(maxAttempts int, backoff ExponentialBackoff) Option {
+	return func(c *Client) {
+		c.faultRetryAttempts = maxAttempts
+		c.faultRetryBackoff = backoff
+	}
+}
+
+// doRequestWithRetry performs a request, retrying on SOAP fault 503 when WithFaultRetry is set
+// Fault handlers are notified once, after the final attempt
+func (c *Client) doRequestWithRetry(ctx context.Context, body []byte, soapAction string, operationName string) ([]byte, *ResponseMeta, error) {
+	var respBody []byte
+	var resp *ResponseMeta
+	var err error
+
+	for attempt := 0; ; attempt++ {
+		respBody, resp, err = c.doRequestOnce(ctx, body, soapAction, operationName)
+
+		var fault *SOAPFault
+		if !errors.As(err, &fault) {
+			return respBody, resp, err
+		}
+		if fault.FaultCode() != faultCodeServiceUnavailable || attempt+1 >= c.faultRetryAttempts {
+			c.notifyFault(operationName, fault)
+			return respBody, resp, err
+		}
+
+		delay := c.faultRetryBackoff.Delay(attempt)
+		c.logger.Warn("DHL24 fault, retrying", "operation", operationName, "faultCode", fault.FaultCode(), "delay", delay)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, resp, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package dhl
+
+import (
+	"context"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestExponentialBackoffDelayBounds(t *testing.T) {
+	backoff := ExponentialBackoff{Base: 100 * time.Millisecond, Cap: time.Second}
+	for attempt := 0; attempt < 10; attempt++ {
+		ceiling := min(time.Second, 100*time.Millisecond<<attempt)
+		for i := 0; i < 100; i++ {
+			if d := backoff.FullJitter().Delay(attempt); d < 0 || d > ceiling {
+				t.Fatalf("full jitter attempt %d: delay %s outside [0, %s]", attempt, d, ceiling)
+			}
+			if d := backoff.EqualJitter().Delay(attempt); d < ceiling/2 || d > ceiling {
+				t.Fatalf("equal jitter attempt %d: delay %s outside [%s, %s]", attempt, d, ceiling/2, ceiling)
+			}
+		}
+	}
+}
+
+func TestFaultRetryOn503(t *testing.T) {
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if calls.Add(1) < 3 {
+			io.WriteString(w, `<Envelope><Body><Fault><faultcode>503</faultcode><faultstring>Service unavailable</faultstring></Fault></Body></Envelope>`)
+			return
+		}
+		io.WriteString(w, `<Envelope><Body><getVersionResponse><getVersionResult>4.0</getVersionResult></getVersionResponse></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	var notified int
+	client := NewClient(&DHL24Config{},
+		WithFaultRetry(3, ExponentialBackoff{Base: time.Millisecond, Cap: 5 * time.Millisecond}),
+		WithFaultCodeAlertHook(func(int, string, *SOAPFault) { notified++ }),
+	)
+	client.endpoint = server.URL
+
+	version, _, err := client.GetVersion(context.Background())
+	if err != nil {
+		t.Fatalf("GetVersion: %v", err)
+	}
+	if version != "4.0" || calls.Load() != 3 {
+		t.Errorf("version %q after %d calls, want 4.0 after 3", version, calls.Load())
+	}
+	if notified != 0 {
+		t.Errorf("fault handler called %d times for a request that succeeded", notified)
+	}
+}
+
+// BenchmarkBackoffSpread reports how retry delays of 100 concurrent goroutines spread over the window
+func BenchmarkBackoffSpread(b *testing.B) {
+	backoffs := map[string]ExponentialBackoff{
+		"full":  ExponentialBackoff{Base: 100 * time.Millisecond, Cap: 10 * time.Second}.FullJitter(),
+		"equal": ExponentialBackoff{Base: 100 * time.Millisecond, Cap: 10 * time.Second}.EqualJitter(),
+	}
+	for name, backoff := range backoffs {
+		b.Run(name, func(b *testing.B) {
+			var stddev float64
+			for i := 0; i < b.N; i++ {
+				delays := make([]float64, 100)
+				var wg sync.WaitGroup
+				for g := range delays {
+					wg.Add(1)
+					go func() {
+						defer wg.Done()
+						delays[g] = float64(backoff.Delay(3)) / float64(time.Millisecond)
+					}()
+				}
+				wg.Wait()
+				stddev = standardDeviation(delays)
+			}
+			b.ReportMetric(stddev, "stddev-ms")
+		})
+	}
+}
+
+func standardDeviation(values []float64) float64 {
+	var sum, sumSquares float64
+	for _, v := range values {
+		sum += v
+		sumSquares += v * v
+	}
+	mean := sum / float64(len(values))
+	return math.Sqrt(sumSquares/float64(len(values)) - mean*mean)
+}
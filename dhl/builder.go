@@ -0,0 +1,144 @@
+package dhl
+
+import (
+	"slices"
+	"strconv"
+	"time"
+)
+
+// ShipmentBuilder builds a ShipmentRequest with chainable setters
+//
+//	req, err := dhl.NewShipmentBuilder().
+//		WithShipper(shipper).
+//		WithReceiver(receiver).
+//		WithProduct("AH").
+//		AddPiece(dhl.Piece{Type: "PACKAGE", Quantity: 1, Weight: 2}).
+//		WithShipmentDate(time.Now()).
+//		Build()
+type ShipmentBuilder struct {
+	item   ShipmentItem
+	config *DHL24Config
+}
+
+// NewShipmentBuilder returns an empty builder
+func NewShipmentBuilder() *ShipmentBuilder {
+	return &ShipmentBuilder{}
+}
+
+// WithShipper sets the shipper address
+func (b *ShipmentBuilder) WithShipper(a Address) *ShipmentBuilder {
+	b.item.Shipper = a
+	return b
+}
+
+// WithReceiver sets the receiver address
+func (b *ShipmentBuilder) WithReceiver(a Address) *ShipmentBuilder {
+	b.item.Receiver = a
+	return b
+}
+
+// WithProduct sets the service product
+func (b *ShipmentBuilder) WithProduct(p ProductCode) *ShipmentBuilder {
+	b.item.Service.Product = p
+	return b
+}
+
+// AddPiece appends a piece to the piece list
+func (b *ShipmentBuilder) AddPiece(p Piece) *ShipmentBuilder {
+	b.item.PieceList.Items = append(b.item.PieceList.Items, p)
+	return b
+}
+
+// WithPayment sets the payment data, empty account number and payment type are filled by SetConfig
+func (b *ShipmentBuilder) WithPayment(p Payment) *ShipmentBuilder {
+	b.item.Payment = p
+	return b
+}
+
+// WithDropOffType sets how the shipment is handed over to DHL
+func (b *ShipmentBuilder) WithDropOffType(t DropOffType) *ShipmentBuilder {
+	if b.item.ShipmentInfo == nil {
+		b.item.ShipmentInfo = &ShipmentInfo{}
+	}
+	b.item.ShipmentInfo.DropOffType = t
+	return b
+}
+
+// WithShipmentDate sets the shipment date, the time of day is ignored
+func (b *ShipmentBuilder) WithShipmentDate(d time.Time) *ShipmentBuilder {
+	b.item.ShipmentDate = d.Format("2006-01-02")
+	return b
+}
+
+// WithContent sets the description of the shipment content
+func (b *ShipmentBuilder) WithContent(s string) *ShipmentBuilder {
+	b.item.Content = s
+	return b
+}
+
+// WithCOD makes the shipment cash on delivery for amount in PLN, sent as the COD special service
+// DHL24 transfers the collected amount to the bank account configured for the DHL24 account
+func (b *ShipmentBuilder) WithCOD(amount float64) *ShipmentBuilder {
+	if b.item.ShipmentInfo == nil {
+		b.item.ShipmentInfo = &ShipmentInfo{}
+	}
+	if b.item.ShipmentInfo.SpecialServices == nil {
+		b.item.ShipmentInfo.SpecialServices = &SpecialServices{}
+	}
+
+	services := b.item.ShipmentInfo.SpecialServices
+	services.Items = slices.DeleteFunc(services.Items, func(item SpecialService) bool { return item.ServiceType == AddonCOD })
+	services.Items = append(services.Items, SpecialService{
+		ServiceType:           AddonCOD,
+		ServiceValue:          strconv.FormatFloat(amount, 'f', 2, 64),
+		CollectOnDeliveryForm: codFormBankTransfer,
+	})
+	return b
+}
+
+// WithServiceAddons adds service add-ons such as Saturday delivery, repeated calls append
+func (b *ShipmentBuilder) WithServiceAddons(addons ...ServiceAddon) *ShipmentBuilder {
+	b.item.ServiceAddons = append(b.item.ServiceAddons, addons...)
+	return b
+}
+
+// WithCustoms sets the customs declaration of an international shipment
+func (b *ShipmentBuilder) WithCustoms(c CustomsData) *ShipmentBuilder {
+	b.item.Customs = &c
+	return b
+}
+
+// SetConfig applies the config defaults with ShipmentItem.ApplyConfigDefaults when Build is called
+func (b *ShipmentBuilder) SetConfig(config *DHL24Config) *ShipmentBuilder {
+	b.config = config
+	return b
+}
+
+// Build validates and returns the shipment request, the error is ValidationErrors listing all violations
+// The builder can be reused, the returned request does not share pieces, add-ons, customs or shipment info with it
+func (b *ShipmentBuilder) Build() (ShipmentRequest, error) {
+	item := b.item
+	item.PieceList.Items = append([]Piece(nil), b.item.PieceList.Items...)
+	item.ServiceAddons = append([]ServiceAddon(nil), b.item.ServiceAddons...)
+	if b.item.Customs != nil {
+		customs := *b.item.Customs
+		customs.Items = append([]CustomsItem(nil), b.item.Customs.Items...)
+		item.Customs = &customs
+	}
+	if b.item.ShipmentInfo != nil {
+		info := *b.item.ShipmentInfo
+		if info.SpecialServices != nil {
+			info.SpecialServices = &SpecialServices{Items: slices.Clone(info.SpecialServices.Items)}
+		}
+		item.ShipmentInfo = &info
+	}
+
+	if b.config != nil {
+		item.ApplyConfigDefaults(b.config)
+	}
+
+	if err := item.Validate(); err != nil {
+		return ShipmentRequest{}, err
+	}
+	return item, nil
+}
@@ -0,0 +1,42 @@
+package dhl
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestShipmentBuilder(t *testing.T) {
+	valid := validShipment()
+	builder := NewShipmentBuilder().
+		WithShipper(valid.Shipper).
+		WithReceiver(valid.Receiver).
+		WithProduct("AH").
+		AddPiece(Piece{Type: "PACKAGE", Quantity: 1, Weight: 2}).
+		WithShipmentDate(time.Now()).
+		WithCOD(50).
+		WithCOD(100).
+		SetConfig(&DHL24Config{AccountNumber: "123", DefaultPaymentType: "BANK_TRANSFER"})
+
+	req, err := builder.Build()
+	if err != nil {
+		t.Fatalf("build: %v", err)
+	}
+	if req.Payment.AccountNumber != "123" || req.Payment.PaymentType != "BANK_TRANSFER" {
+		t.Errorf("request = %+v", req)
+	}
+	cod := SpecialService{ServiceType: AddonCOD, ServiceValue: "100.00", CollectOnDeliveryForm: "BANK_TRANSFER"}
+	if services := req.ShipmentInfo.SpecialServices.Items; len(services) != 1 || services[0] != cod {
+		t.Errorf("special services = %+v, want [%+v]", services, cod)
+	}
+
+	builder.WithCOD(200)
+	if got := req.ShipmentInfo.SpecialServices.Items[0].ServiceValue; got != "100.00" {
+		t.Errorf("built request changed by the builder, COD value = %s", got)
+	}
+
+	var errs ValidationErrors
+	if _, err := NewShipmentBuilder().Build(); !errors.As(err, &errs) {
+		t.Errorf("empty builder: expected ValidationErrors, got %v", err)
+	}
+}
@@ -0,0 +1,74 @@
+package dhl
+
+import (
+	"sync"
+	"time"
+)
+
+// ttlCache is a simple thread-safe in-memory cache with per-entry expiration
+type ttlCache[K comparable, V any] struct {
+	mu      sync.Mutex
+	ttl     time.Duration
+	entries map[K]ttlEntry[V]
+}
+
+// ttlEntry holds a cached value with its expiration time
+type ttlEntry[V any] struct {
+	value   V
+	expires time.Time
+}
+
+// newTTLCache creates a cache where entries expire after ttl
+func newTTLCache[K comparable, V any](ttl time.Duration) *ttlCache[K, V] {
+	return &ttlCache[K, V]{
+		ttl:     ttl,
+		entries: make(map[K]ttlEntry[V]),
+	}
+}
+
+// get returns the cached value if present and not expired
+func (c *ttlCache[K, V]) get(key K) (V, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok || time.Now().After(entry.expires) {
+		delete(c.entries, key)
+		var zero V
+		return zero, false
+	}
+	return entry.value, true
+}
+
+// set stores a value that expires after the cache ttl
+func (c *ttlCache[K, V]) set(key K, value V) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries[key] = ttlEntry[V]{value: value, expires: time.Now().Add(c.ttl)}
+}
+
+// len returns the number of entries that have not expired
+func (c *ttlCache[K, V]) len() int {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	n := 0
+	for key, entry := range c.entries {
+		if now.After(entry.expires) {
+			delete(c.entries, key)
+			continue
+		}
+		n++
+	}
+	return n
+}
+
+// clear removes all entries
+func (c *ttlCache[K, V]) clear() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.entries = make(map[K]ttlEntry[V])
+}
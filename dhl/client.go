@@ -8,41 +8,143 @@ import (
 	"bytes"
 	"context"
 	"encoding/xml"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
 	"os"
 	"path/filepath"
+	"strings"
+	"sync"
+	"sync/atomic"
 	"time"
+
+	"go.opentelemetry.io/otel/trace"
+	"golang.org/x/sync/semaphore"
 )
 
 const (
 	// Endpoint is the DHL24 WebAPI endpoint
 	Endpoint = "https://dhl24.com.pl/webapi2/provider/service.html?ws=1"
 
+	// SandboxEndpoint is the DHL24 WebAPI test environment endpoint
+	SandboxEndpoint = "https://sandbox.dhl24.com.pl/webapi2/provider/service.html?ws=1"
+
+	// defaultTimeout bounds an API call that has no per-operation timeout
+	defaultTimeout = 15 * time.Second
+
+	// myShipmentsPageSize is the maximum number of records returned by getMyShipments
+	myShipmentsPageSize = 100
+
 	// SOAP namespace constants
 	soapenvNS = "http://schemas.xmlsoap.org/soap/envelope/"
 	dhlNS     = "https://dhl24.com.pl/webapi2/provider/service.html?ws=1"
+	sandboxNS = "https://sandbox.dhl24.com.pl/webapi2/provider/service.html?ws=1"
 )
 
+// ResponseMeta describes the HTTP response of a SOAP call, the body is already read and closed
+type ResponseMeta struct {
+	StatusCode int
+	Headers    http.Header
+	// DurationMs is the time from sending the request until the response body was read, including network retries
+	DurationMs int64
+}
+
 // Client represents a DHL24 API client
 type Client struct {
 	httpClient    *http.Client
+	timeout       time.Duration
 	config        *DHL24Config
+	endpoint      string
+	sandbox       bool
 	debugFiles    bool
 	debugFilesDir string
+
+	versionCache       *ttlCache[string, VersionInfo]
+	statusCache        *ttlCache[ShipmentID, OrderStatus]
+	faultHandlers      []FaultHandler
+	labelCache         *labelCache
+	credentials        CredentialProvider
+	dedupCache         *ttlCache[string, CreatedShipment]
+	templates          *SOAPTemplateEngine
+	customTemplates    map[string]bool
+	streamBufferSize   int
+	defaultCountry     CountryCode
+	deadlineThreshold  float64
+	deadlineHandler    func(operation string, remaining time.Duration)
+	soapDedupWindow    time.Duration
+	soapDedup          sync.Map
+	semaphore          *semaphore.Weighted
+	activeRequests     atomic.Int64
+	faultRetryAttempts int
+	faultRetryBackoff  ExponentialBackoff
+	retryPolicy        RetryPolicy
+	middlewares        []Middleware
+	postalCodeLookup   PostalCodeLookup
+	zoneMap            zoneMapCache
+	debugLogger        Logger
+	logger             Logger
+	resolvingDialer    *resolvingDialer
+	customTransport    bool
+	dryRun             bool
+	requestHook        RequestHook
+	resultHooks        []ResultHook
+	tracer             trace.Tracer
+	gzipRequests       bool
 }
 
 // NewClient creates a new DHL24 API client
-func NewClient(config *DHL24Config) *Client {
-	return &Client{
-		httpClient: &http.Client{
-			Timeout: 15 * time.Second,
-		},
+// Uses the sandbox endpoint when config.Sandbox is set
+func NewClient(config *DHL24Config, opts ...Option) *Client {
+	endpoint := Endpoint
+	if config.Sandbox {
+		endpoint = SandboxEndpoint
+	}
+
+	// Built-in templates are embedded, so a parse error is a programming error
+	templates, err := NewSOAPTemplateEngine()
+	if err != nil {
+		panic(err)
+	}
+
+	c := &Client{
+		httpClient:    &http.Client{},
+		timeout:       defaultTimeout,
 		config:        config,
+		endpoint:      endpoint,
+		sandbox:       config.Sandbox,
 		debugFiles:    config.DebugFiles,
 		debugFilesDir: config.DebugFilesDir,
+
+		statusCache:     newTTLCache[ShipmentID, OrderStatus](statusCacheTTL),
+		versionCache:    newTTLCache[string, VersionInfo](versionCacheTTL),
+		templates:       templates,
+		customTemplates: make(map[string]bool),
+		defaultCountry:  DefaultCountry,
+		logger:          noopLogger{},
+	}
+
+	for _, opt := range opts {
+		opt(c)
 	}
+	if c.gzipRequests {
+		c.installGzipTransport()
+	}
+
+	return c
+}
+
+// IsSandbox reports whether the client targets the DHL24 sandbox, set by DHL24Config.Sandbox or WithSandbox
+func (c *Client) IsSandbox() bool {
+	return c.sandbox
+}
+
+// namespace returns the DHL24 SOAP namespace, it depends on the environment and not on the endpoint URL
+func (c *Client) namespace() string {
+	if c.sandbox {
+		return sandboxNS
+	}
+	return dhlNS
 }
 
 // getExecutableDir returns the directory where the executable is located
@@ -63,7 +165,7 @@ func (c *Client) writeDebugFile(prefix string, payload []byte) {
 	}
 
 	if err := os.MkdirAll(dir, 0755); err != nil {
-		fmt.Printf("Warning: failed to create debug directory %s: %v\n", dir, err)
+		c.logger.Warn("failed to create debug directory", "dir", dir, "error", err)
 		return
 	}
 
@@ -72,17 +174,22 @@ func (c *Client) writeDebugFile(prefix string, payload []byte) {
 	fullPath := filepath.Join(dir, filename)
 
 	if err := os.WriteFile(fullPath, payload, 0644); err != nil {
-		fmt.Printf("Warning: failed to write debug file %s: %v\n", fullPath, err)
+		c.logger.Warn("failed to write debug file", "path", fullPath, "error", err)
 	} else {
-		fmt.Printf("Debug: wrote %s\n", fullPath)
+		c.logger.Debug("wrote debug file", "path", fullPath)
 	}
 }
 
 // marshalSOAPRequest creates a SOAP envelope with the given body and marshals it to XML
-func (c *Client) marshalSOAPRequest(body interface{}) ([]byte, error) {
+// If a custom template is registered for the operation, the envelope is rendered from it instead
+func (c *Client) marshalSOAPRequest(operation string, body interface{}) ([]byte, error) {
+	if c.customTemplates[operation] {
+		return c.templates.Render(operation, templateData{Namespace: c.namespace(), Endpoint: c.endpoint, Request: body})
+	}
+
 	envelope := SOAPEnvelope{
 		Soapenv: soapenvNS,
-		NS:      dhlNS,
+		NS:      c.namespace(),
 		Body:    SOAPBody{Content: body},
 	}
 
@@ -96,39 +203,161 @@ func (c *Client) marshalSOAPRequest(body interface{}) ([]byte, error) {
 }
 
 // doRequest performs an HTTP request and optionally logs request/response to files
-func (c *Client) doRequest(ctx context.Context, body []byte, soapAction string, operationName string) ([]byte, *http.Response, error) {
-	if c.debugFiles {
-		c.writeDebugFile(operationName+"_request", body)
+// A SOAP fault in the response is returned as *SOAPFault error
+// Requests larger than the configured limit are rejected with ErrRequestTooLarge before sending
+// The context is limited by the operation timeout from DHL24Config when one is set
+func (c *Client) doRequest(ctx context.Context, body []byte, soapAction string, operationName string) ([]byte, *ResponseMeta, error) {
+	if timeout := c.requestTimeout(operationName); timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	return c.traceRequest(ctx, operationName, func(ctx context.Context) ([]byte, *ResponseMeta, error) {
+		if c.soapDedupWindow > 0 {
+			return c.doRequestDeduplicated(ctx, body, soapAction, operationName)
+		}
+		return c.doRequestWithRetry(ctx, body, soapAction, operationName)
+	})
+}
+
+// requestTimeout returns the timeout of an API call, the per-operation timeout from the config or the client timeout
+// It is applied as a context deadline covering retries and reading the response, the default HTTP client has no timeout
+func (c *Client) requestTimeout(operationName string) time.Duration {
+	if timeout := c.config.operationTimeout(operationName); timeout > 0 {
+		return timeout
 	}
+	return c.timeout
+}
 
-	req, err := http.NewRequestWithContext(ctx, http.MethodPost, Endpoint, bytes.NewReader(body))
+// doRequestOnce sends a single request to the API without deduplication or retries
+func (c *Client) doRequestOnce(ctx context.Context, body []byte, soapAction string, operationName string) ([]byte, *ResponseMeta, error) {
+	release, err := c.acquireRequestSlot(ctx)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error creating request: %w", err)
+		return nil, nil, err
 	}
+	defer release()
 
-	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
-	req.Header.Set("SOAPAction", soapAction)
+	if stop := c.startDeadlineWarning(ctx, operationName); stop != nil {
+		defer stop()
+	}
 
-	resp, err := c.httpClient.Do(req)
+	start := time.Now()
+	respBody, meta, err := c.sendAndRead(ctx, body, soapAction, operationName, start)
+	if len(c.resultHooks) > 0 {
+		hookMeta := meta
+		if hookMeta == nil {
+			hookMeta = &ResponseMeta{DurationMs: time.Since(start).Milliseconds()}
+		}
+		for _, hook := range c.resultHooks {
+			hook(operationName, hookMeta, err)
+		}
+	}
+	return respBody, meta, err
+}
+
+// sendAndRead sends the request and reads the response, a SOAP fault in the response is returned as error
+func (c *Client) sendAndRead(ctx context.Context, body []byte, soapAction string, operationName string, start time.Time) ([]byte, *ResponseMeta, error) {
+	resp, err := c.sendRequestWithRetry(ctx, body, soapAction, operationName)
 	if err != nil {
-		return nil, nil, fmt.Errorf("error making request: %w", err)
+		return nil, nil, err
 	}
 	defer resp.Body.Close()
 
 	respBody, err := io.ReadAll(resp.Body)
+	meta := &ResponseMeta{
+		StatusCode: resp.StatusCode,
+		Headers:    resp.Header,
+		DurationMs: time.Since(start).Milliseconds(),
+	}
 	if err != nil {
-		return nil, resp, fmt.Errorf("error reading response: %w", err)
+		return nil, meta, fmt.Errorf("error reading response: %w", err)
 	}
 
 	if c.debugFiles {
 		c.writeDebugFile(operationName+"_response", respBody)
 	}
+	if c.debugLogger != nil {
+		c.debugLogger.Debug("DHL24 response", "operation", operationName, "status", resp.StatusCode, "body", string(respBody))
+	}
+	if c.requestHook != nil {
+		c.requestHook(operationName, redactPassword(body), respBody)
+	}
+
+	respBody, err = detectAndConvertEncoding(respBody)
+	if err != nil {
+		return nil, meta, err
+	}
 
-	return respBody, resp, nil
+	if fault := parseSOAPFault(respBody); fault != nil {
+		return nil, meta, fault
+	}
+
+	return respBody, meta, nil
 }
 
-// authData returns AuthData populated from client config
+// sendRequest posts a SOAP request and returns the response with an unread body
+// The caller must close the response body
+func (c *Client) sendRequest(ctx context.Context, body []byte, soapAction string, operationName string) (*http.Response, error) {
+	maxSize := c.config.MaxRequestBodySize
+	if maxSize <= 0 {
+		maxSize = DefaultMaxRequestBodySize
+	}
+	if size := int64(len(body)); size > maxSize {
+		return nil, ErrRequestTooLarge{ActualSize: size, MaxSize: maxSize}
+	}
+
+	if c.debugFiles {
+		c.writeDebugFile(operationName+"_request", body)
+	}
+	if c.debugLogger != nil {
+		c.debugLogger.Debug("DHL24 request", "operation", operationName, "body", string(redactPassword(body)))
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+
+	req.Header.Set("Content-Type", "text/xml; charset=utf-8")
+	req.Header.Set("SOAPAction", soapAction)
+	c.injectTraceContext(ctx, req.Header)
+
+	resp, err := c.roundTrip(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	return resp, nil
+}
+
+// startDeadlineWarning schedules the deadline warning handler for a request
+// Returns a function that cancels the warning, or nil if no warning is scheduled
+func (c *Client) startDeadlineWarning(ctx context.Context, operationName string) func() {
+	if c.deadlineHandler == nil || c.deadlineThreshold <= 0 || c.deadlineThreshold >= 1 {
+		return nil
+	}
+
+	deadline, ok := ctx.Deadline()
+	if !ok {
+		return nil
+	}
+
+	delay := time.Duration(float64(time.Until(deadline)) * c.deadlineThreshold)
+	timer := time.AfterFunc(delay, func() {
+		c.deadlineHandler(operationName, time.Until(deadline))
+	})
+
+	return func() { timer.Stop() }
+}
+
+// authData returns AuthData populated from the credential provider or client config
 func (c *Client) authData() AuthData {
+	if c.credentials != nil {
+		return AuthData{
+			Username: c.credentials.Username(),
+			Password: c.credentials.Password(),
+		}
+	}
 	return AuthData{
 		Username: c.config.Username,
 		Password: c.config.Password,
@@ -137,13 +366,13 @@ func (c *Client) authData() AuthData {
 
 // GetVersion retrieves the DHL24 WebAPI version
 // This is the only method that doesn't require authentication
-func (c *Client) GetVersion(ctx context.Context) (string, *http.Response, error) {
-	reqBody, err := c.marshalSOAPRequest(GetVersionRequest{})
+func (c *Client) GetVersion(ctx context.Context) (string, *ResponseMeta, error) {
+	reqBody, err := c.marshalSOAPRequest("getVersion", GetVersionRequest{})
 	if err != nil {
 		return "", nil, err
 	}
 
-	body, resp, err := c.doRequest(ctx, reqBody, Endpoint+"#getVersion", "getVersion")
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#getVersion", "getVersion")
 	if err != nil {
 		return "", resp, err
 	}
@@ -160,28 +389,52 @@ func (c *Client) GetVersion(ctx context.Context) (string, *http.Response, error)
 	return envelope.Body.GetVersionResponse.Version, resp, nil
 }
 
-// CreateShipments creates new shipments
+// CreateShipments creates new shipments in a single createShipments call
+// Results are in the same order as reqs
 // Documentation: https://dhl24.com.pl/en/webapi2/doc.html
 // Product codes: https://dhl24.com.pl/en/webapi2/doc/service/createShipment.html
-// Common products: AH (DHL Parcel), PR (Premium), EK (Express 9:00), DR (Express 12:00), etc.
+// Common products: AH (DHL Parcel), PR (Premium), 09 (Domestic 09), 12 (Domestic 12), see ValidProductCodes
 // Possible responses:
 //   - Fault 100: Invalid credentials
 //   - Fault 101: Missing required parameter
 //   - Fault 131: Product retrieval error (product not available for account)
-func (c *Client) CreateShipments(ctx context.Context, shipments []ShipmentItem) ([]CreatedShipment, *http.Response, error) {
+//
+// When the deduplication cache is enabled, items already created within the cache TTL
+// are not sent again and their previous results are returned instead
+func (c *Client) CreateShipments(ctx context.Context, reqs []ShipmentRequest) ([]CreatedShipment, *ResponseMeta, error) {
+	if c.dryRun {
+		return c.createShipmentsDryRun(ctx, reqs)
+	}
+	if c.dedupCache == nil {
+		return c.createShipments(ctx, reqs)
+	}
+	return c.createShipmentsDeduplicated(ctx, reqs)
+}
+
+// createShipments sends shipments to the createShipments operation
+// An empty receiver country is set to the client default country
+func (c *Client) createShipments(ctx context.Context, shipments []ShipmentItem) ([]CreatedShipment, *ResponseMeta, error) {
+	items := make([]ShipmentItem, len(shipments))
+	for i, item := range shipments {
+		if item.Receiver.Country == "" {
+			item.Receiver.Country = string(c.defaultCountry)
+		}
+		items[i] = item.withServiceAddons()
+	}
+
 	request := CreateShipmentsRequest{
 		AuthData: c.authData(),
 		Shipments: Shipments{
-			Items: shipments,
+			Items: items,
 		},
 	}
 
-	reqBody, err := c.marshalSOAPRequest(request)
+	reqBody, err := c.marshalSOAPRequest("createShipments", request)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	body, resp, err := c.doRequest(ctx, reqBody, Endpoint+"#createShipments", "createShipments")
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#createShipments", "createShipments")
 	if err != nil {
 		return nil, resp, err
 	}
@@ -195,12 +448,17 @@ func (c *Client) CreateShipments(ctx context.Context, shipments []ShipmentItem)
 		return nil, resp, fmt.Errorf("empty createShipments response")
 	}
 
-	return envelope.Body.CreateShipmentsResponse.Result.Items, resp, nil
+	created := envelope.Body.CreateShipmentsResponse.Result.Items
+	if len(created) != len(items) {
+		return nil, resp, fmt.Errorf("createShipments returned %d results for %d shipments", len(created), len(items))
+	}
+
+	return created, resp, nil
 }
 
-// CreateShipment creates a single shipment (convenience wrapper)
-func (c *Client) CreateShipment(ctx context.Context, shipment ShipmentItem) (*CreatedShipment, *http.Response, error) {
-	results, resp, err := c.CreateShipments(ctx, []ShipmentItem{shipment})
+// CreateShipment creates a single shipment from the caller's request (convenience wrapper)
+func (c *Client) CreateShipment(ctx context.Context, req ShipmentRequest) (*CreatedShipment, *ResponseMeta, error) {
+	results, resp, err := c.CreateShipments(ctx, []ShipmentRequest{req})
 	if err != nil {
 		return nil, resp, err
 	}
@@ -215,7 +473,7 @@ func (c *Client) CreateShipment(ctx context.Context, shipment ShipmentItem) (*Cr
 // GetMyShipments retrieves shipments list for the specified date range
 // Documentation: https://dhl24.com.pl/en/webapi2/doc/info/getMyShipments.html
 // Returns maximum 100 records per request (use offset for pagination)
-func (c *Client) GetMyShipments(ctx context.Context, createdFrom, createdTo string, offset int) ([]ShipmentBasicData, *http.Response, error) {
+func (c *Client) GetMyShipments(ctx context.Context, createdFrom, createdTo string, offset int) ([]ShipmentBasicData, *ResponseMeta, error) {
 	request := GetMyShipmentsRequest{
 		AuthData:    c.authData(),
 		CreatedFrom: createdFrom,
@@ -223,12 +481,22 @@ func (c *Client) GetMyShipments(ctx context.Context, createdFrom, createdTo stri
 		Offset:      offset,
 	}
 
-	reqBody, err := c.marshalSOAPRequest(request)
+	result, resp, err := c.getMyShipments(ctx, request)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return result.Items, resp, nil
+}
+
+// getMyShipments sends a getMyShipments request and returns the full result
+func (c *Client) getMyShipments(ctx context.Context, request GetMyShipmentsRequest) (*GetMyShipmentsResult, *ResponseMeta, error) {
+	reqBody, err := c.marshalSOAPRequest("getMyShipments", request)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	body, resp, err := c.doRequest(ctx, reqBody, Endpoint+"#getMyShipments", "getMyShipments")
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#getMyShipments", "getMyShipments")
 	if err != nil {
 		return nil, resp, err
 	}
@@ -238,20 +506,166 @@ func (c *Client) GetMyShipments(ctx context.Context, createdFrom, createdTo stri
 		return nil, resp, fmt.Errorf("error parsing response: %w", err)
 	}
 
-	return envelope.Body.Response.Result.Items, resp, nil
+	return &envelope.Body.Response.Result, resp, nil
 }
 
 // GetMyShipmentsLastDays retrieves shipments from the last N days
-func (c *Client) GetMyShipmentsLastDays(ctx context.Context, days int) ([]ShipmentBasicData, *http.Response, error) {
+func (c *Client) GetMyShipmentsLastDays(ctx context.Context, days int) ([]ShipmentBasicData, *ResponseMeta, error) {
 	createdTo := time.Now().Format("2006-01-02")
 	createdFrom := time.Now().AddDate(0, 0, -days).Format("2006-01-02")
 	return c.GetMyShipments(ctx, createdFrom, createdTo, 0)
 }
 
-// PrintShipments prints shipments in a compact one-line format
-func PrintShipments(shipments []ShipmentBasicData) {
-	fmt.Printf("Found %d shipment(s):\n", len(shipments))
-	for _, shipment := range shipments {
-		fmt.Printf("%-30s | %s | %-20s | %s\n", shipment.ShipmentID, shipment.Created, shipment.OrderStatus, shipment.Receiver.Name)
+// GetLabel retrieves the label document for a shipment and returns decoded bytes
+// Documentation: https://dhl24.com.pl/en/webapi2/doc/service/getLabels.html
+// When the label cache is enabled, cached labels are returned with a nil *ResponseMeta
+func (c *Client) GetLabel(ctx context.Context, shipmentID ShipmentID, labelType LabelType) ([]byte, *ResponseMeta, error) {
+	cacheKey := labelCacheKey{shipmentID: string(shipmentID), labelType: labelType}
+	if c.labelCache != nil {
+		if data, ok := c.labelCache.get(cacheKey); ok {
+			return data, nil, nil
+		}
+	}
+
+	label, resp, err := c.getLabel(ctx, shipmentID, labelType)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	data, err := label.Bytes()
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if c.labelCache != nil {
+		c.labelCache.set(cacheKey, data)
+	}
+
+	return data, resp, nil
+}
+
+// getLabel retrieves a single label from the getLabels operation without decoding it
+func (c *Client) getLabel(ctx context.Context, shipmentID ShipmentID, labelType LabelType) (*Label, *ResponseMeta, error) {
+	request := GetLabelsRequest{
+		AuthData: c.authData(),
+		ItemsToPrint: ItemsToPrint{
+			Items: []ItemToPrint{{LabelType: labelType, ShipmentID: string(shipmentID)}},
+		},
+	}
+
+	reqBody, err := c.marshalSOAPRequest("getLabels", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#getLabels", "getLabels")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var envelope SOAPResponseEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, resp, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	if envelope.Body.GetLabelsResponse == nil || len(envelope.Body.GetLabelsResponse.Result.Items) == 0 {
+		return nil, resp, fmt.Errorf("empty getLabels response")
+	}
+
+	return &envelope.Body.GetLabelsResponse.Result.Items[0], resp, nil
+}
+
+// CancelShipment cancels a shipment that has not been picked up yet
+// Returns an error matching ErrShipmentAlreadyPickedUp when the courier already has the shipment
+// Documentation: https://dhl24.com.pl/en/webapi2/doc/service/deleteShipments.html
+func (c *Client) CancelShipment(ctx context.Context, shipmentID ShipmentID) (bool, *ResponseMeta, error) {
+	request := DeleteShipmentsRequest{
+		AuthData:  c.authData(),
+		Shipments: ShipmentIDs{Items: []string{string(shipmentID)}},
 	}
+
+	reqBody, err := c.marshalSOAPRequest("deleteShipments", request)
+	if err != nil {
+		return false, nil, err
+	}
+
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#deleteShipments", "deleteShipments")
+	if errors.Is(err, ErrShipmentAlreadyPickedUp) {
+		return false, resp, fmt.Errorf("shipment %s not cancelled: %w", shipmentID, err)
+	}
+	if err != nil {
+		return false, resp, err
+	}
+
+	var envelope SOAPResponseEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return false, resp, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	if envelope.Body.DeleteShipmentsResponse == nil || len(envelope.Body.DeleteShipmentsResponse.Result.Items) == 0 {
+		return false, resp, fmt.Errorf("empty deleteShipments response")
+	}
+
+	result := envelope.Body.DeleteShipmentsResponse.Result.Items[0]
+	if !result.Result {
+		return false, resp, fmt.Errorf("shipment %s not cancelled: %w", shipmentID, parseItemFault(result.Error))
+	}
+
+	return true, resp, nil
+}
+
+// DeleteShipments cancels several shipments in a single deleteShipments call
+// Shipments that could not be cancelled, e.g. because they were already picked up, are reported
+// in their DeleteResult without failing the batch; results are in the same order as ids
+// and matched by the returned shipment ID, a shipment missing from the response is reported as not cancelled
+func (c *Client) DeleteShipments(ctx context.Context, ids []ShipmentID) ([]DeleteResult, *ResponseMeta, error) {
+	items := make([]string, len(ids))
+	for i, id := range ids {
+		items[i] = string(id)
+	}
+
+	request := DeleteShipmentsRequest{
+		AuthData:  c.authData(),
+		Shipments: ShipmentIDs{Items: items},
+	}
+
+	reqBody, err := c.marshalSOAPRequest("deleteShipments", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#deleteShipments", "deleteShipments")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var envelope SOAPResponseEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, resp, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	if envelope.Body.DeleteShipmentsResponse == nil {
+		return nil, resp, fmt.Errorf("empty deleteShipments response")
+	}
+
+	deleted := make(map[ShipmentID]DeletedShipment, len(ids))
+	for _, item := range envelope.Body.DeleteShipmentsResponse.Result.Items {
+		deleted[ShipmentID(strings.TrimSpace(item.ID))] = item
+	}
+
+	results := make([]DeleteResult, len(ids))
+	for i, id := range ids {
+		results[i] = DeleteResult{ShipmentID: id}
+		item, ok := deleted[id]
+		switch {
+		case !ok:
+			results[i].Error = &SOAPFault{Message: "shipment missing from deleteShipments response"}
+		case !item.Result:
+			results[i].Error = parseItemFault(item.Error)
+		default:
+			results[i].Success = true
+		}
+	}
+
+	return results, resp, nil
 }
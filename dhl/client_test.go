@@ -0,0 +1,188 @@
+package dhl
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMarshalSOAPRequestEscapesSpecialCharacters(t *testing.T) {
+	client := NewClient(&DHL24Config{Username: `user&<"x">`})
+	request := CreateShipmentsRequest{
+		AuthData: client.authData(),
+		Shipments: Shipments{Items: []ShipmentItem{{
+			Receiver: Address{Name: "NIÑO PERESOZO </name><injected/>"},
+		}}},
+	}
+
+	body, err := client.marshalSOAPRequest("createShipments", request)
+	if err != nil {
+		t.Fatalf("marshal: %v", err)
+	}
+	if strings.Contains(string(body), "<injected/>") {
+		t.Fatalf("markup in field value was not escaped:\n%s", body)
+	}
+
+	var envelope struct {
+		Body struct {
+			CreateShipments struct {
+				AuthData  AuthData  `xml:"authData"`
+				Shipments Shipments `xml:"shipments"`
+			} `xml:"createShipments"`
+		} `xml:"Body"`
+	}
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got := envelope.Body.CreateShipments.Shipments.Items[0].Receiver.Name; got != "NIÑO PERESOZO </name><injected/>" {
+		t.Errorf("receiver name = %q", got)
+	}
+	if got := envelope.Body.CreateShipments.AuthData.Username; got != `user&<"x">` {
+		t.Errorf("username = %q", got)
+	}
+}
+
+func TestRequestHookReceivesBodies(t *testing.T) {
+	const response = `<Envelope><Body><getVersionResponse><getVersionResult>2.5.0</getVersionResult></getVersionResponse></Body></Envelope>`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, response)
+	}))
+	defer server.Close()
+
+	var operation, request, reply string
+	hook := func(op string, req, resp []byte) {
+		operation, request, reply = op, string(req), string(resp)
+	}
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL), WithRequestHook(hook))
+
+	if _, _, err := client.GetVersion(context.Background()); err != nil {
+		t.Fatalf("get version: %v", err)
+	}
+	if operation != "getVersion" || !strings.Contains(request, "getVersion") || reply != response {
+		t.Errorf("hook got operation %q, request %q, response %q", operation, request, reply)
+	}
+}
+
+func TestTemplateRendersTypedFields(t *testing.T) {
+	engine, err := NewSOAPTemplateEngine()
+	if err != nil {
+		t.Fatalf("new engine: %v", err)
+	}
+	request := CreateShipmentsRequest{
+		Shipments: Shipments{Items: []ShipmentItem{{
+			PieceList: PieceList{Items: []Piece{{Type: "PACKAGE", Quantity: 1, Weight: 2, Width: 20}}},
+			Payment:   Payment{PayerType: PaymentTypeShipper},
+			Service:   Service{Product: ProductParcel},
+			ShipmentInfo: &ShipmentInfo{
+				DropOffType: DropOffRegularPickup,
+				Billing:     &Billing{ShippingPaymentType: PaymentTypeReceiver},
+			},
+		}}},
+	}
+
+	body, err := engine.Render("createShipments", templateData{Namespace: "urn:test", Request: request})
+	if err != nil {
+		t.Fatalf("render: %v", err)
+	}
+	for _, want := range []string{
+		"<width>20</width>",
+		"<payerType>SHIPPER</payerType>",
+		"<product>AH</product>",
+		"<dropOffType>REGULAR_PICKUP</dropOffType>",
+		"<shippingPaymentType>RECEIVER</shippingPaymentType>",
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("rendered body does not contain %s", want)
+		}
+	}
+}
+
+func TestResultHookReceivesFault(t *testing.T) {
+	const fault = `<Envelope><Body><Fault><faultcode>100</faultcode><faultstring>Invalid credentials</faultstring></Fault></Body></Envelope>`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, fault)
+	}))
+	defer server.Close()
+
+	var calls int
+	var gotMeta *ResponseMeta
+	var gotErr error
+	hook := func(op string, meta *ResponseMeta, err error) {
+		calls++
+		gotMeta, gotErr = meta, err
+	}
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL), WithResultHook(hook))
+
+	if _, _, err := client.GetVersion(context.Background()); err == nil {
+		t.Fatal("expected fault error")
+	}
+	var soapFault *SOAPFault
+	if calls != 1 || gotMeta.StatusCode != http.StatusInternalServerError || !errors.As(gotErr, &soapFault) || soapFault.FaultCode() != 100 {
+		t.Errorf("hook got %d calls, meta %+v, error %v", calls, gotMeta, gotErr)
+	}
+}
+
+func TestWithEndpointKeepsNamespace(t *testing.T) {
+	var action, request string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		action, request = r.Header.Get("SOAPAction"), string(body)
+		io.WriteString(w, `<Envelope><Body><getVersionResponse><getVersionResult>2.5.0</getVersionResult></getVersionResponse></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	for _, tc := range []struct {
+		config DHL24Config
+		ns     string
+	}{
+		{DHL24Config{}, dhlNS},
+		{DHL24Config{Sandbox: true}, sandboxNS},
+	} {
+		client := NewClient(&tc.config, WithEndpoint(server.URL))
+		if _, _, err := client.GetVersion(context.Background()); err != nil {
+			t.Fatalf("get version: %v", err)
+		}
+		if client.IsSandbox() != tc.config.Sandbox {
+			t.Errorf("IsSandbox() = %v with endpoint %s", client.IsSandbox(), server.URL)
+		}
+		if !strings.Contains(request, `xmlns:ns="`+escapeXML(tc.ns)+`"`) || !strings.Contains(action, tc.ns+"#getVersion") {
+			t.Errorf("namespace %s not used, SOAPAction %q, request:\n%s", tc.ns, action, request)
+		}
+	}
+}
+
+func TestOperationTimeoutOverridesClientTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		time.Sleep(100 * time.Millisecond)
+		io.WriteString(w, `<Envelope><Body><getVersionResponse><getVersionResult>2.5.0</getVersionResult></getVersionResponse></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	httpClient := &http.Client{}
+	config := &DHL24Config{GetVersionTimeout: Duration(time.Second)}
+	client := NewClient(config, WithHTTPClient(httpClient), WithEndpoint(server.URL), WithTimeout(20*time.Millisecond), WithAutoRefreshCredentials(staticCredentials{}))
+
+	if _, _, err := client.GetVersion(context.Background()); err != nil {
+		t.Errorf("GetVersion() with a 1s operation timeout: %v", err)
+	}
+	if _, _, err := client.GetMyShipments(context.Background(), "2026-10-01", "2026-10-02", 0); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("GetMyShipments() with a 20ms client timeout: %v, want deadline exceeded", err)
+	}
+	if httpClient.Timeout != 0 || httpClient.Transport != nil {
+		t.Errorf("caller's HTTP client changed: %+v", httpClient)
+	}
+}
+
+func TestWithHTTPClientIgnoresNil(t *testing.T) {
+	client := NewClient(&DHL24Config{}, WithHTTPClient(nil))
+	if client.httpClient == nil {
+		t.Error("WithHTTPClient(nil) removed the default HTTP client")
+	}
+}
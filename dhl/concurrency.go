@@ -0,0 +1,40 @@
+package dhl
+
+import (
+	"context"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// WithMaxConcurrency limits the number of concurrent in-flight API calls of the client to n
+// Requests block until a slot is free or their context is cancelled
+func WithMaxConcurrency(n int) Option {
+	return func(c *Client) {
+		if n > 0 {
+			c.semaphore = semaphore.NewWeighted(int64(n))
+		}
+	}
+}
+
+// ActiveRequests returns the number of API calls currently in flight
+func (c *Client) ActiveRequests() int64 {
+	return c.activeRequests.Load()
+}
+
+// acquireRequestSlot waits for a free concurrency slot and counts the request as active
+// The returned function must be called when the request is finished
+func (c *Client) acquireRequestSlot(ctx context.Context) (func(), error) {
+	if c.semaphore != nil {
+		if err := c.semaphore.Acquire(ctx, 1); err != nil {
+			return nil, err
+		}
+	}
+	c.activeRequests.Add(1)
+
+	return func() {
+		c.activeRequests.Add(-1)
+		if c.semaphore != nil {
+			c.semaphore.Release(1)
+		}
+	}, nil
+}
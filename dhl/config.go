@@ -2,37 +2,243 @@ package dhl
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
 )
 
 // Config represents the application configuration
 type Config struct {
-	DHL24 DHL24Config `json:"dhl24"`
+	DHL24 DHL24Config `json:"dhl24" yaml:"dhl24"`
 }
 
 // DHL24Config contains DHL24 API credentials and settings
 type DHL24Config struct {
-	Username      string `json:"username"`
-	Password      string `json:"password"`
-	AccountNumber string `json:"accountNumber"`
-	DebugFiles    bool   `json:"debugFiles"`
-	DebugFilesDir string `json:"debugFilesDir"`
+	Username      string `json:"username" yaml:"username"`
+	Password      string `json:"password" yaml:"password"`
+	AccountNumber string `json:"accountNumber" yaml:"accountNumber"`
+	DebugFiles    bool   `json:"debugFiles" yaml:"debugFiles"`
+	DebugFilesDir string `json:"debugFilesDir" yaml:"debugFilesDir"`
+	Sandbox       bool   `json:"sandbox" yaml:"sandbox"`
+
+	// DefaultLabelFormat is the label type used by GetLabelDefaultFormat and ShipmentItem.ApplyConfigDefaults
+	DefaultLabelFormat LabelType `json:"defaultLabelFormat" yaml:"defaultLabelFormat"`
+
+	// DefaultDropOffType and DefaultPaymentType are applied by ShipmentItem.ApplyConfigDefaults
+	DefaultDropOffType DropOffType `json:"defaultDropOffType" yaml:"defaultDropOffType"`
+	DefaultPaymentType string      `json:"defaultPaymentType" yaml:"defaultPaymentType"`
+
+	// MaxRequestBodySize limits the SOAP request size in bytes, 0 means DefaultMaxRequestBodySize
+	MaxRequestBodySize int64 `json:"maxRequestBodySize" yaml:"maxRequestBodySize"`
+
+	// Per-operation timeouts (e.g. "30s") replace the client timeout (WithTimeout) for the operation,
+	// 0 means the client timeout applies
+	GetVersionTimeout     Duration `json:"getVersionTimeout" yaml:"getVersionTimeout"`
+	CreateShipmentTimeout Duration `json:"createShipmentTimeout" yaml:"createShipmentTimeout"`
+	GetMyShipmentsTimeout Duration `json:"getMyShipmentsTimeout" yaml:"getMyShipmentsTimeout"`
+	GetLabelTimeout       Duration `json:"getLabelTimeout" yaml:"getLabelTimeout"`
+}
+
+// Duration is a time.Duration read from JSON or YAML as a string such as "30s" or a number of seconds
+type Duration time.Duration
+
+// UnmarshalJSON implements json.Unmarshaler
+func (d *Duration) UnmarshalJSON(data []byte) error {
+	var value any
+	if err := json.Unmarshal(data, &value); err != nil {
+		return err
+	}
+
+	switch v := value.(type) {
+	case float64:
+		*d = Duration(v * float64(time.Second))
+	case string:
+		parsed, err := time.ParseDuration(v)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q: %w", v, err)
+		}
+		*d = Duration(parsed)
+	case nil:
+		*d = 0
+	default:
+		return fmt.Errorf("invalid duration %s", data)
+	}
+	return nil
+}
+
+// UnmarshalYAML implements yaml.Unmarshaler
+func (d *Duration) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Tag {
+	case "!!int", "!!float":
+		seconds, err := strconv.ParseFloat(value.Value, 64)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q: %w", value.Value, err)
+		}
+		*d = Duration(seconds * float64(time.Second))
+	case "!!null":
+		*d = 0
+	case "!!str":
+		parsed, err := time.ParseDuration(value.Value)
+		if err != nil {
+			return fmt.Errorf("invalid duration %q: %w", value.Value, err)
+		}
+		*d = Duration(parsed)
+	default:
+		return fmt.Errorf("invalid duration %q", value.Value)
+	}
+	return nil
+}
+
+// MarshalJSON implements json.Marshaler
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
 }
 
-// LoadConfig reads configuration from config.json file
-func LoadConfig() (*Config, error) {
-	file, err := os.Open("config.json")
+// operationTimeout returns the configured timeout of a SOAP operation, or 0 if none is set
+func (c *DHL24Config) operationTimeout(operation string) time.Duration {
+	switch operation {
+	case "getVersion":
+		return time.Duration(c.GetVersionTimeout)
+	case "createShipments":
+		return time.Duration(c.CreateShipmentTimeout)
+	case "getMyShipments", "getMyShipmentsCount":
+		return time.Duration(c.GetMyShipmentsTimeout)
+	case "getLabels":
+		return time.Duration(c.GetLabelTimeout)
+	}
+	return 0
+}
+
+// DefaultMaxRequestBodySize is the default SOAP request size limit (1 MB)
+const DefaultMaxRequestBodySize int64 = 1 << 20
+
+// DefaultConfigPath is the config file read by LoadConfigAuto, relative to the working directory
+const DefaultConfigPath = "config.json"
+
+// LoadConfig reads configuration from the JSON file at path
+func LoadConfig(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
 	if err != nil {
-		return nil, fmt.Errorf("failed to open config.json: %w (copy config.example.json to config.json)", err)
+		return nil, fmt.Errorf("failed to open %s: %w (copy config.example.json to %s)", path, err, path)
+	}
+	return decodeConfig(path, data)
+}
+
+// LoadConfigFromEnv reads configuration from DHL24_* environment variables
+func LoadConfigFromEnv() (*Config, error) {
+	config := Config{
+		DHL24: DHL24Config{
+			Username:      os.Getenv("DHL24_USERNAME"),
+			Password:      os.Getenv("DHL24_PASSWORD"),
+			AccountNumber: os.Getenv("DHL24_ACCOUNT_NUMBER"),
+			DebugFilesDir: os.Getenv("DHL24_DEBUG_FILES_DIR"),
+		},
 	}
-	defer file.Close()
 
+	var err error
+	if config.DHL24.DebugFiles, err = envBool("DHL24_DEBUG_FILES"); err != nil {
+		return nil, err
+	}
+	if config.DHL24.Sandbox, err = envBool("DHL24_SANDBOX"); err != nil {
+		return nil, err
+	}
+
+	if err := config.DHL24.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid DHL24_* environment: %w", err)
+	}
+
+	return &config, nil
+}
+
+// Validate checks that the username, password and account number are set
+func (c *DHL24Config) Validate() error {
+	var errs []error
+	required := []struct {
+		name  string
+		value string
+	}{
+		{"username", c.Username},
+		{"password", c.Password},
+		{"accountNumber", c.AccountNumber},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			errs = append(errs, fmt.Errorf("%s is required", field.name))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// LoadConfigFromYAML reads configuration from a YAML file with the same keys as config.json
+func LoadConfigFromYAML(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open %s: %w", path, err)
+	}
+
+	// Unquoted numbers such as accountNumber: 123456 are accepted for string fields
+	var config Config
+	if err := yaml.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if err := config.DHL24.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", path, err)
+	}
+
+	return &config, nil
+}
+
+// LoadConfigFromFile reads configuration from a JSON or YAML file, the format is inferred from the extension
+func LoadConfigFromFile(path string) (*Config, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		return LoadConfigFromYAML(path)
+	case ".json":
+		return LoadConfig(path)
+	}
+	return nil, fmt.Errorf("unsupported config format %q, use .json, .yaml or .yml", filepath.Ext(path))
+}
+
+// decodeConfig parses and validates JSON configuration read from path
+func decodeConfig(path string, data []byte) (*Config, error) {
 	var config Config
-	decoder := json.NewDecoder(file)
-	if err := decoder.Decode(&config); err != nil {
-		return nil, fmt.Errorf("failed to parse config.json: %w", err)
+	if err := json.Unmarshal(data, &config); err != nil {
+		return nil, fmt.Errorf("failed to parse %s: %w", path, err)
+	}
+
+	if err := config.DHL24.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid %s: %w", path, err)
 	}
 
 	return &config, nil
 }
+
+// LoadConfigAuto reads configuration from environment variables when DHL24_USERNAME is set,
+// otherwise from DefaultConfigPath
+func LoadConfigAuto() (*Config, error) {
+	if os.Getenv("DHL24_USERNAME") != "" {
+		return LoadConfigFromEnv()
+	}
+	return LoadConfig(DefaultConfigPath)
+}
+
+// envBool parses a boolean environment variable, unset means false
+func envBool(name string) (bool, error) {
+	value := os.Getenv(name)
+	if value == "" {
+		return false, nil
+	}
+
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, fmt.Errorf("invalid %s value %q: %w", name, value, err)
+	}
+	return b, nil
+}
@@ -0,0 +1,70 @@
+package dhl
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestLoadConfigFromFileYAML(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	data := `dhl24:
+  username: user
+  password: secret
+  accountNumber: "123456"
+  sandbox: true
+  getLabelTimeout: 30s
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadConfigFromFile(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	got := config.DHL24
+	if got.Username != "user" || got.AccountNumber != "123456" || !got.Sandbox || time.Duration(got.GetLabelTimeout) != 30*time.Second {
+		t.Errorf("config = %+v", got)
+	}
+}
+
+func TestLoadConfigFromFileMissingField(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.json")
+	if err := os.WriteFile(path, []byte(`{"dhl24":{"username":"user"}}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := LoadConfigFromFile(path); err == nil {
+		t.Fatal("expected error for missing password and account number")
+	}
+}
+
+func TestLoadConfigFromFileYAMLUnquotedNumbers(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yml")
+	data := `dhl24:
+  username: 1001
+  password: 123456
+  accountNumber: 6000000
+  getVersionTimeout: 5
+  createShipmentTimeout: 1.5
+`
+	if err := os.WriteFile(path, []byte(data), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	config, err := LoadConfigFromFile(path)
+	if err != nil {
+		t.Fatalf("load: %v", err)
+	}
+
+	got := config.DHL24
+	if got.Username != "1001" || got.Password != "123456" || got.AccountNumber != "6000000" {
+		t.Errorf("credentials = %q, %q, %q", got.Username, got.Password, got.AccountNumber)
+	}
+	if time.Duration(got.GetVersionTimeout) != 5*time.Second || time.Duration(got.CreateShipmentTimeout) != 1500*time.Millisecond {
+		t.Errorf("timeouts = %v, %v", got.GetVersionTimeout, got.CreateShipmentTimeout)
+	}
+}
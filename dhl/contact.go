@@ -0,0 +1,100 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	// phonePattern accepts digits with optional leading + and separators
+	phonePattern = regexp.MustCompile(`^\+?[0-9][0-9 -]{6,}[0-9]$`)
+	// emailPattern is a basic sanity check, not a full RFC 5322 validation
+	emailPattern = regexp.MustCompile(`^[^@\s]+@[^@\s]+\.[^@\s]+$`)
+)
+
+// Validate checks that a phone number is set and that phone and email are well formed
+func (c ContactInfo) Validate() error {
+	var errs []error
+	if c.Phone == "" {
+		errs = append(errs, fmt.Errorf("contactPhone is required"))
+	} else if !phonePattern.MatchString(strings.TrimSpace(c.Phone)) {
+		errs = append(errs, fmt.Errorf("invalid contactPhone %q", c.Phone))
+	}
+	if c.Email != "" && !emailPattern.MatchString(c.Email) {
+		errs = append(errs, fmt.Errorf("invalid contactEmail %q", c.Email))
+	}
+	return errors.Join(errs...)
+}
+
+// IsEmpty reports whether no contact fields are set
+func (c ContactInfo) IsEmpty() bool {
+	return c.Person == "" && c.Phone == "" && c.Email == ""
+}
+
+// WithPhone returns a copy of the contact with the phone number replaced
+func (c ContactInfo) WithPhone(phone string) ContactInfo {
+	c.Phone = phone
+	return c
+}
+
+// WithEmail returns a copy of the contact with the email replaced
+func (c ContactInfo) WithEmail(email string) ContactInfo {
+	c.Email = email
+	return c
+}
+
+// WithPerson returns a copy of the contact with the contact person replaced
+func (c ContactInfo) WithPerson(person string) ContactInfo {
+	c.Person = person
+	return c
+}
+
+// Contact returns the contact details of the address
+func (a Address) Contact() ContactInfo {
+	return ContactInfo{Person: a.ContactPerson, Phone: a.ContactPhone, Email: a.ContactEmail}
+}
+
+// WithContact returns a copy of the address with contact details replaced
+func (a Address) WithContact(c ContactInfo) Address {
+	a.ContactPerson = c.Person
+	a.ContactPhone = c.Phone
+	a.ContactEmail = c.Email
+	return a
+}
+
+// Contact returns the contact details of the address
+func (a AddressInfo) Contact() ContactInfo {
+	return ContactInfo{Person: a.ContactPerson, Phone: a.ContactPhone, Email: a.ContactEmail}
+}
+
+// ReceiverContact returns the contact details of the shipment receiver
+func (s ShipmentBasicData) ReceiverContact() ContactInfo {
+	return s.Receiver.Contact()
+}
+
+// ShipperContact returns the contact details of the shipment shipper
+func (s ShipmentBasicData) ShipperContact() ContactInfo {
+	return s.Shipper.Contact()
+}
+
+// HasReceiverEmail reports whether the receiver has an email address
+func (s ShipmentBasicData) HasReceiverEmail() bool {
+	return strings.TrimSpace(s.Receiver.ContactEmail) != ""
+}
+
+// HasReceiverPhone reports whether the receiver has a phone number
+func (s ShipmentBasicData) HasReceiverPhone() bool {
+	return strings.TrimSpace(s.Receiver.ContactPhone) != ""
+}
+
+// NotifyReceiver sends a message to the shipment receiver
+// DHL24 has no notification operation yet, so this always returns an error wrapping errors.ErrUnsupported
+func (s ShipmentBasicData) NotifyReceiver(ctx context.Context, msg string) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	return fmt.Errorf("notifying receiver of shipment %s: %w", s.ShipmentID, errors.ErrUnsupported)
+}
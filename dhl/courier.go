@@ -0,0 +1,59 @@
+package dhl
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// CourierBooking describes a courier pickup request
+type CourierBooking struct {
+	PickupDate       time.Time
+	PickupTimeFrom   string // HH:MM
+	PickupTimeTo     string // HH:MM
+	ShipmentIDs      []ShipmentID
+	AdditionalInfo   string
+	CourierWithLabel bool
+}
+
+// BookCourier orders a courier pickup for the given shipments and returns the pickup confirmation numbers
+// Documentation: https://dhl24.com.pl/en/webapi2/doc/service/bookCourier.html
+func (c *Client) BookCourier(ctx context.Context, booking CourierBooking) ([]string, *ResponseMeta, error) {
+	day := booking.PickupDate.Format("2006-01-02")
+	items := make([]string, len(booking.ShipmentIDs))
+	for i, id := range booking.ShipmentIDs {
+		items[i] = string(id)
+	}
+
+	request := BookCourierRequest{
+		AuthData:         c.authData(),
+		PickupDate:       day,
+		PickupTimeFrom:   booking.PickupTimeFrom,
+		PickupTimeTo:     booking.PickupTimeTo,
+		AdditionalInfo:   booking.AdditionalInfo,
+		ShipmentIDList:   ShipmentIDs{Items: items},
+		CourierWithLabel: booking.CourierWithLabel,
+	}
+
+	reqBody, err := c.marshalSOAPRequest("bookCourier", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#bookCourier", "bookCourier")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var envelope SOAPResponseEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, resp, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	if envelope.Body.BookCourierResponse == nil || len(envelope.Body.BookCourierResponse.Result.Items) == 0 {
+		return nil, resp, fmt.Errorf("empty bookCourier response")
+	}
+
+	return envelope.Body.BookCourierResponse.Result.Items, resp, nil
+}
@@ -0,0 +1,45 @@
+package dhl
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestDeadlineWarningFiresBeforeTimeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.Copy(io.Discard, r.Body)
+		select {
+		case <-r.Context().Done():
+		case <-time.After(time.Second):
+		}
+	}))
+	defer server.Close()
+
+	warned := make(chan time.Duration, 1)
+	client := NewClient(&DHL24Config{}, WithDeadlineWarning(0.5, func(operation string, remaining time.Duration) {
+		if operation == "getVersion" {
+			warned <- remaining
+		}
+	}))
+	client.endpoint = server.URL
+
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+
+	if _, _, err := client.GetVersion(ctx); err == nil {
+		t.Fatal("expected timeout error")
+	}
+
+	select {
+	case remaining := <-warned:
+		if remaining <= 0 || remaining > 60*time.Millisecond {
+			t.Errorf("remaining = %v, expected around 50ms", remaining)
+		}
+	default:
+		t.Fatal("deadline warning did not fire")
+	}
+}
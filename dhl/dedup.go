@@ -0,0 +1,143 @@
+package dhl
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strings"
+	"time"
+)
+
+// WithDeduplicationCache prevents creating the same shipment twice within ttl
+// Shipments are identified by shipper name, receiver name, receiver postal code and shipment date
+func WithDeduplicationCache(ttl time.Duration) Option {
+	return func(c *Client) {
+		c.dedupCache = newTTLCache[string, CreatedShipment](ttl)
+	}
+}
+
+// DeduplicationCacheSize returns the number of shipments currently remembered by the deduplication cache
+func (c *Client) DeduplicationCacheSize() int {
+	if c.dedupCache == nil {
+		return 0
+	}
+	return c.dedupCache.len()
+}
+
+// FlushDeduplicationCache forgets all previously created shipments
+func (c *Client) FlushDeduplicationCache() {
+	if c.dedupCache != nil {
+		c.dedupCache.clear()
+	}
+}
+
+// shipmentHash returns the deduplication key of a shipment item
+func shipmentHash(item ShipmentItem) string {
+	key := strings.Join([]string{
+		item.Shipper.Name,
+		item.Receiver.Name,
+		item.Receiver.PostalCode,
+		item.ShipmentDate,
+	}, "\x00")
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// createShipmentsDeduplicated creates only shipments not seen within the cache TTL
+// Returned results keep the order of the input items
+// The *ResponseMeta is nil when all items were served from the cache
+func (c *Client) createShipmentsDeduplicated(ctx context.Context, shipments []ShipmentItem) ([]CreatedShipment, *ResponseMeta, error) {
+	results := make([]CreatedShipment, len(shipments))
+	hashes := make([]string, len(shipments))
+
+	var pending []ShipmentItem
+	var pendingIdx []int
+	for i, item := range shipments {
+		hashes[i] = shipmentHash(item)
+		if created, ok := c.dedupCache.get(hashes[i]); ok {
+			c.logger.Warn("duplicate shipment detected, returning existing shipment", "receiver", item.Receiver.Name, "shipmentId", created.ShipmentID)
+			results[i] = created
+			continue
+		}
+		pending = append(pending, item)
+		pendingIdx = append(pendingIdx, i)
+	}
+
+	if len(pending) == 0 {
+		return results, nil, nil
+	}
+
+	created, resp, err := c.createShipments(ctx, pending)
+	if err != nil {
+		return nil, resp, err
+	}
+	for j, i := range pendingIdx {
+		results[i] = created[j]
+		c.dedupCache.set(hashes[i], created[j])
+	}
+
+	return results, resp, nil
+}
+
+// soapDedupEntry holds the result of a request shared by identical requests within the window
+type soapDedupEntry struct {
+	done chan struct{}
+	body []byte
+	resp *ResponseMeta
+	err  error
+}
+
+// WithSOAPDeduplication returns the cached response when an identical SOAP request body was sent within window
+// Identical requests sent while the first one is in flight wait for its result instead of calling the API
+// Only successful responses are shared, a request whose leader failed is sent again by the waiting caller
+// Failed requests are not cached. This complements WithDeduplicationCache, which works on shipment data
+func WithSOAPDeduplication(window time.Duration) Option {
+	return func(c *Client) {
+		c.soapDedupWindow = window
+	}
+}
+
+// doRequestDeduplicated performs a request unless an identical one was sent within the deduplication window
+func (c *Client) doRequestDeduplicated(ctx context.Context, body []byte, soapAction string, operationName string) ([]byte, *ResponseMeta, error) {
+	sum := sha256.Sum256(body)
+	key := hex.EncodeToString(sum[:])
+
+	for {
+		entry := &soapDedupEntry{done: make(chan struct{})}
+		existing, loaded := c.soapDedup.LoadOrStore(key, entry)
+		if !loaded {
+			return c.sendDeduplicated(ctx, key, entry, body, soapAction, operationName)
+		}
+
+		shared := existing.(*soapDedupEntry)
+		select {
+		case <-shared.done:
+		case <-ctx.Done():
+			return nil, nil, ctx.Err()
+		}
+		if shared.err == nil {
+			c.logger.Warn("duplicate request detected, returning cached response", "operation", operationName)
+			return bytes.Clone(shared.body), shared.resp, nil
+		}
+		// The leader failed, e.g. its context was cancelled, so the request is sent again with this caller's context
+	}
+}
+
+// sendDeduplicated performs the request for entry and shares a successful response for the deduplication window
+// A failed entry is removed before waiting callers are released, so they do not see it again
+func (c *Client) sendDeduplicated(ctx context.Context, key string, entry *soapDedupEntry, body []byte, soapAction string, operationName string) ([]byte, *ResponseMeta, error) {
+	entry.body, entry.resp, entry.err = c.doRequestWithRetry(ctx, body, soapAction, operationName)
+	if entry.err != nil {
+		c.soapDedup.CompareAndDelete(key, entry)
+		close(entry.done)
+		return entry.body, entry.resp, entry.err
+	}
+
+	close(entry.done)
+	time.AfterFunc(c.soapDedupWindow, func() {
+		c.soapDedup.CompareAndDelete(key, entry)
+	})
+
+	return bytes.Clone(entry.body), entry.resp, nil
+}
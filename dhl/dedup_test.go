@@ -0,0 +1,151 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCreateShipmentsDeduplication(t *testing.T) {
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := atomic.AddInt32(&calls, 1)
+		fmt.Fprintf(w, `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/">
+  <SOAP-ENV:Body>
+    <createShipmentsResponse>
+      <createShipmentsResult><item><shipmentId>9000000000%d</shipmentId></item></createShipmentsResult>
+    </createShipmentsResponse>
+  </SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`, n)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithDeduplicationCache(5*time.Minute))
+	client.endpoint = server.URL
+
+	shipment := ShipmentItem{
+		Shipper:      Address{Name: "Shipper"},
+		Receiver:     Address{Name: "Receiver", PostalCode: "01249"},
+		ShipmentDate: "2026-10-20",
+	}
+
+	first, _, err := client.CreateShipments(context.Background(), []ShipmentItem{shipment})
+	if err != nil {
+		t.Fatalf("first create: %v", err)
+	}
+
+	time.Sleep(time.Second)
+
+	second, _, err := client.CreateShipments(context.Background(), []ShipmentItem{shipment})
+	if err != nil {
+		t.Fatalf("second create: %v", err)
+	}
+
+	if first[0].ShipmentID != second[0].ShipmentID {
+		t.Errorf("shipment IDs differ: %s != %s", first[0].ShipmentID, second[0].ShipmentID)
+	}
+	if got := atomic.LoadInt32(&calls); got != 1 {
+		t.Errorf("expected 1 API call, got %d", got)
+	}
+	if got := client.DeduplicationCacheSize(); got != 1 {
+		t.Errorf("cache size = %d, want 1", got)
+	}
+
+	client.FlushDeduplicationCache()
+	if got := client.DeduplicationCacheSize(); got != 0 {
+		t.Errorf("cache size after flush = %d, want 0", got)
+	}
+}
+
+func TestSOAPDeduplicationReturnsCopies(t *testing.T) {
+	const response = `<Envelope><Body><getVersionResponse><getVersionResult>2.5.0</getVersionResult></getVersionResponse></Body></Envelope>`
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		io.WriteString(w, response)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL), WithSOAPDeduplication(time.Minute))
+	request := []byte("<getVersion/>")
+
+	first, _, err := client.doRequest(context.Background(), request, "getVersion", "getVersion")
+	if err != nil {
+		t.Fatalf("first request: %v", err)
+	}
+	first[0] = 'X'
+
+	second, _, err := client.doRequest(context.Background(), request, "getVersion", "getVersion")
+	if err != nil {
+		t.Fatalf("second request: %v", err)
+	}
+	if string(second) != response {
+		t.Errorf("cached body = %q, want %q", second, response)
+	}
+	if n := atomic.LoadInt32(&calls); n != 1 {
+		t.Errorf("API called %d times, want 1", n)
+	}
+}
+
+func TestSOAPDeduplicationRetriesWhenLeaderIsCancelled(t *testing.T) {
+	const response = `<Envelope><Body><getVersionResponse><getVersionResult>2.5.0</getVersionResult></getVersionResponse></Body></Envelope>`
+	var calls int32
+	leaderSent := make(chan struct{})
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.ReadAll(r.Body)
+		if atomic.AddInt32(&calls, 1) == 1 {
+			close(leaderSent)
+			select {
+			case <-r.Context().Done():
+			case <-release:
+			}
+			return
+		}
+		io.WriteString(w, response)
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL), WithSOAPDeduplication(time.Minute))
+
+	leaderCtx, cancel := context.WithCancel(context.Background())
+	leaderErr := make(chan error, 1)
+	go func() {
+		_, _, err := client.GetVersion(leaderCtx)
+		leaderErr <- err
+	}()
+	<-leaderSent
+
+	type result struct {
+		version string
+		err     error
+	}
+	waiter := make(chan result, 1)
+	go func() {
+		version, _, err := client.GetVersion(context.Background())
+		waiter <- result{version, err}
+	}()
+
+	// give the waiter time to find the in-flight request before the leader is cancelled
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	if err := <-leaderErr; !errors.Is(err, context.Canceled) {
+		t.Errorf("leader error = %v, want context.Canceled", err)
+	}
+	got := <-waiter
+	if got.err != nil || got.version != "2.5.0" {
+		t.Errorf("waiter = %q, %v, want 2.5.0 from its own request", got.version, got.err)
+	}
+	if n := atomic.LoadInt32(&calls); n != 2 {
+		t.Errorf("API called %d times, want 2", n)
+	}
+}
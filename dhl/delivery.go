@@ -0,0 +1,22 @@
+package dhl
+
+import (
+	"errors"
+	"fmt"
+	"time"
+)
+
+// ErrNoDeliveryDate is returned by CreatedShipment.DeliveryDate when the response had no estimated delivery date
+var ErrNoDeliveryDate = errors.New("no estimated delivery date")
+
+// DeliveryDate returns the estimated delivery date returned by createShipments
+func (s CreatedShipment) DeliveryDate() (time.Time, error) {
+	if s.EstimatedDeliveryDate == "" {
+		return time.Time{}, ErrNoDeliveryDate
+	}
+	date, err := time.Parse("2006-01-02", s.EstimatedDeliveryDate)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error parsing delivery date: %w", err)
+	}
+	return date, nil
+}
@@ -0,0 +1,142 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+)
+
+// DefaultDNSTimeout bounds a single DNS lookup made by NewTimeoutDNSResolver and the DNS options
+const DefaultDNSTimeout = 5 * time.Second
+
+// NewTimeoutDNSResolver returns a resolver whose connections to DNS servers time out after timeout
+// A timeout of 0 uses DefaultDNSTimeout
+func NewTimeoutDNSResolver(timeout time.Duration) *net.Resolver {
+	if timeout <= 0 {
+		timeout = DefaultDNSTimeout
+	}
+	dialer := net.Dialer{Timeout: timeout}
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			ctx, cancel := context.WithTimeout(ctx, timeout)
+			defer cancel()
+			return dialer.DialContext(ctx, network, address)
+		},
+	}
+}
+
+// WithDNSResolver resolves the API host with resolver, each lookup is limited to DefaultDNSTimeout
+// The option configures the *http.Transport of the client, custom RoundTrippers are left unchanged
+func WithDNSResolver(resolver *net.Resolver) Option {
+	return func(c *Client) {
+		if dialer := c.dnsDialer(); dialer != nil {
+			dialer.resolver = resolver
+		}
+	}
+}
+
+// WithDNSCacheTimeout caches resolved addresses of the API host for ttl
+// This avoids repeated lookups when connections are re-established after failures
+func WithDNSCacheTimeout(ttl time.Duration) Option {
+	return func(c *Client) {
+		if dialer := c.dnsDialer(); dialer != nil {
+			dialer.cache = newTTLCache[string, []string](ttl)
+		}
+	}
+}
+
+// resolvingDialer dials TCP connections using a configurable resolver and address cache
+type resolvingDialer struct {
+	resolver *net.Resolver
+	cache    *ttlCache[string, []string]
+	dialer   net.Dialer
+}
+
+// DialContext implements the http.Transport DialContext hook
+func (d *resolvingDialer) DialContext(ctx context.Context, network, address string) (net.Conn, error) {
+	host, port, err := net.SplitHostPort(address)
+	if err != nil || net.ParseIP(host) != nil {
+		return d.dialer.DialContext(ctx, network, address)
+	}
+
+	addrs, err := d.lookup(ctx, host)
+	if err != nil {
+		return nil, err
+	}
+
+	var errs []error
+	for _, addr := range addrs {
+		conn, err := d.dialer.DialContext(ctx, network, net.JoinHostPort(addr, port))
+		if err == nil {
+			return conn, nil
+		}
+		errs = append(errs, err)
+	}
+	return nil, errors.Join(errs...)
+}
+
+// lookup resolves host, using cached addresses when available
+func (d *resolvingDialer) lookup(ctx context.Context, host string) ([]string, error) {
+	if d.cache != nil {
+		if addrs, ok := d.cache.get(host); ok {
+			return addrs, nil
+		}
+	}
+
+	resolver := d.resolver
+	if resolver == nil {
+		resolver = net.DefaultResolver
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, DefaultDNSTimeout)
+	defer cancel()
+	addrs, err := resolver.LookupHost(ctx, host)
+	if err != nil {
+		return nil, fmt.Errorf("error resolving %s: %w", host, err)
+	}
+
+	if d.cache != nil {
+		d.cache.set(host, addrs)
+	}
+	return addrs, nil
+}
+
+// dnsDialer returns the dialer installed in the client transport, installing it on first use
+// Returns nil when the transport is not an *http.Transport and cannot be configured
+func (c *Client) dnsDialer() *resolvingDialer {
+	if c.resolvingDialer != nil {
+		return c.resolvingDialer
+	}
+
+	transport := configurableTransport(&c.httpClient.Transport)
+	if transport == nil {
+		c.logger.Warn("DNS options ignored, client transport is not an *http.Transport")
+		return nil
+	}
+
+	c.resolvingDialer = &resolvingDialer{dialer: net.Dialer{Timeout: 30 * time.Second, KeepAlive: 30 * time.Second}}
+	transport.DialContext = c.resolvingDialer.DialContext
+	return c.resolvingDialer
+}
+
+// configurableTransport returns a private *http.Transport stored in rt, looking through AuthenticatedTransport
+// A nil RoundTripper is replaced with a clone of http.DefaultTransport
+func configurableTransport(rt *http.RoundTripper) *http.Transport {
+	switch t := (*rt).(type) {
+	case nil:
+		clone := http.DefaultTransport.(*http.Transport).Clone()
+		*rt = clone
+		return clone
+	case *http.Transport:
+		clone := t.Clone()
+		*rt = clone
+		return clone
+	case *AuthenticatedTransport:
+		return configurableTransport(&t.Base)
+	}
+	return nil
+}
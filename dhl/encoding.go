@@ -0,0 +1,59 @@
+package dhl
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/text/encoding"
+	"golang.org/x/text/encoding/charmap"
+)
+
+// xmlEncodingPattern matches the encoding attribute of the XML declaration
+var xmlEncodingPattern = regexp.MustCompile(`^\s*<\?xml[^>]*?encoding=["']([A-Za-z0-9._-]+)["']`)
+
+// xmlCharmaps maps XML declaration encoding names to their charmap decoders
+var xmlCharmaps = map[string]encoding.Encoding{
+	"iso-8859-2":   charmap.ISO8859_2,
+	"iso8859-2":    charmap.ISO8859_2,
+	"latin2":       charmap.ISO8859_2,
+	"windows-1250": charmap.Windows1250,
+	"cp1250":       charmap.Windows1250,
+	"iso-8859-1":   charmap.ISO8859_1,
+	"latin1":       charmap.ISO8859_1,
+}
+
+// detectAndConvertEncoding converts an XML document to UTF-8 based on its declared encoding
+// Documents without a declaration or declared as UTF-8 are returned unchanged
+// The declaration itself is rewritten to UTF-8 so encoding/xml can parse the result
+func detectAndConvertEncoding(b []byte) ([]byte, error) {
+	match := xmlEncodingPattern.FindSubmatchIndex(b)
+	if match == nil {
+		return b, nil
+	}
+
+	name := strings.ToLower(string(b[match[2]:match[3]]))
+	if name == "utf-8" || name == "utf8" {
+		return b, nil
+	}
+
+	enc, ok := xmlCharmaps[name]
+	if !ok {
+		return nil, fmt.Errorf("unsupported response encoding %q", name)
+	}
+
+	decoded, err := enc.NewDecoder().Bytes(b)
+	if err != nil {
+		return nil, fmt.Errorf("error converting response from %s: %w", name, err)
+	}
+
+	// Declaration is ASCII, so offsets are unchanged after decoding
+	var converted bytes.Buffer
+	converted.Grow(len(decoded))
+	converted.Write(decoded[:match[2]])
+	converted.WriteString("UTF-8")
+	converted.Write(decoded[match[3]:])
+
+	return converted.Bytes(), nil
+}
@@ -0,0 +1,51 @@
+package dhl
+
+import (
+	"encoding/xml"
+	"os"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestDetectAndConvertEncodingLatin2(t *testing.T) {
+	raw, err := os.ReadFile("testdata/getMyShipments_iso-8859-2.xml")
+	if err != nil {
+		t.Fatalf("read fixture: %v", err)
+	}
+	if utf8.Valid(raw) {
+		t.Fatal("fixture is expected to contain non UTF-8 bytes")
+	}
+
+	converted, err := detectAndConvertEncoding(raw)
+	if err != nil {
+		t.Fatalf("convert: %v", err)
+	}
+
+	var envelope GetMyShipmentsEnvelope
+	if err := xml.Unmarshal(converted, &envelope); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+
+	items := envelope.Body.Response.Result.Items
+	if len(items) != 1 {
+		t.Fatalf("expected 1 shipment, got %d", len(items))
+	}
+	if got, want := items[0].Receiver.Name, "Paweł Żółkiewski"; got != want {
+		t.Errorf("receiver name = %q, want %q", got, want)
+	}
+	if got, want := items[0].Shipper.Street, "Osmańska"; got != want {
+		t.Errorf("shipper street = %q, want %q", got, want)
+	}
+}
+
+func TestDetectAndConvertEncodingUTF8Unchanged(t *testing.T) {
+	raw := []byte(`<?xml version="1.0" encoding="UTF-8"?><a>Łódź</a>`)
+
+	converted, err := detectAndConvertEncoding(raw)
+	if err != nil {
+		t.Fatalf("convert: %v", err)
+	}
+	if string(converted) != string(raw) {
+		t.Errorf("UTF-8 document was modified: %s", converted)
+	}
+}
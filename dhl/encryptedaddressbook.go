@@ -0,0 +1,212 @@
+package dhl
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"sync"
+)
+
+// encryptionKeySize is the AES-256 key size in bytes
+const encryptionKeySize = 32
+
+// EncryptedAddressBook stores addresses in an AddressBook with every field encrypted using AES-256-GCM
+// Encrypted values are stored as base64, lookups decrypt all stored addresses since the encryption is not searchable
+type EncryptedAddressBook struct {
+	mu   sync.RWMutex
+	aead cipher.AEAD
+	book *AddressBook
+}
+
+// NewEncryptedAddressBook wraps book, key must be 32 bytes long
+// Addresses already present in book are expected to be encrypted with the same key
+func NewEncryptedAddressBook(key []byte, book *AddressBook) (*EncryptedAddressBook, error) {
+	aead, err := newAddressCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return &EncryptedAddressBook{aead: aead, book: book}, nil
+}
+
+// Add encrypts and stores an address, replacing any existing address with the same name
+func (e *EncryptedAddressBook) Add(address Address) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	stored, err := e.find(address.Name)
+	if err != nil {
+		return err
+	}
+	encrypted, err := transformAddress(address, func(s string) (string, error) { return encryptField(e.aead, s) })
+	if err != nil {
+		return err
+	}
+	if stored != "" {
+		e.book.Remove(stored)
+	}
+	e.book.Add(encrypted)
+	return nil
+}
+
+// Get returns the decrypted address stored under name
+func (e *EncryptedAddressBook) Get(name string) (Address, bool, error) {
+	addresses, err := e.List()
+	if err != nil {
+		return Address{}, false, err
+	}
+	for _, address := range addresses {
+		if address.Name == name {
+			return address, true, nil
+		}
+	}
+	return Address{}, false, nil
+}
+
+// Search returns decrypted addresses whose name, city, street or postal code contains query, ignoring case
+func (e *EncryptedAddressBook) Search(query string) ([]Address, error) {
+	addresses, err := e.List()
+	if err != nil {
+		return nil, err
+	}
+	var found []Address
+	for _, address := range addresses {
+		if address.matches(query) {
+			found = append(found, address)
+		}
+	}
+	return found, nil
+}
+
+// List returns all decrypted addresses
+func (e *EncryptedAddressBook) List() ([]Address, error) {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+	return e.decryptAll(e.aead)
+}
+
+// RotateKey re-encrypts all stored addresses with newKey
+// The book is left unchanged if any address fails to decrypt or encrypt
+func (e *EncryptedAddressBook) RotateKey(newKey []byte) error {
+	aead, err := newAddressCipher(newKey)
+	if err != nil {
+		return err
+	}
+
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	stored := e.book.List()
+	addresses, err := e.decryptAll(e.aead)
+	if err != nil {
+		return err
+	}
+
+	reencrypted := make([]Address, len(addresses))
+	for i, address := range addresses {
+		if reencrypted[i], err = transformAddress(address, func(s string) (string, error) { return encryptField(aead, s) }); err != nil {
+			return err
+		}
+	}
+
+	for _, address := range stored {
+		e.book.Remove(address.Name)
+	}
+	for _, address := range reencrypted {
+		e.book.Add(address)
+	}
+	e.aead = aead
+	return nil
+}
+
+// find returns the encrypted name under which name is stored, or "" if it is not stored
+func (e *EncryptedAddressBook) find(name string) (string, error) {
+	for _, stored := range e.book.List() {
+		decrypted, err := decryptField(e.aead, stored.Name)
+		if err != nil {
+			return "", err
+		}
+		if decrypted == name {
+			return stored.Name, nil
+		}
+	}
+	return "", nil
+}
+
+// decryptAll decrypts every stored address with aead
+func (e *EncryptedAddressBook) decryptAll(aead cipher.AEAD) ([]Address, error) {
+	stored := e.book.List()
+	addresses := make([]Address, len(stored))
+	for i, address := range stored {
+		var err error
+		if addresses[i], err = transformAddress(address, func(s string) (string, error) { return decryptField(aead, s) }); err != nil {
+			return nil, err
+		}
+	}
+	return addresses, nil
+}
+
+// newAddressCipher creates an AES-256-GCM cipher from key
+func newAddressCipher(key []byte) (cipher.AEAD, error) {
+	if len(key) != encryptionKeySize {
+		return nil, fmt.Errorf("encryption key must be %d bytes, got %d", encryptionKeySize, len(key))
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("error creating cipher: %w", err)
+	}
+	return aead, nil
+}
+
+// encryptField encrypts a value as base64 of nonce and ciphertext, empty values stay empty
+func encryptField(aead cipher.AEAD, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return "", fmt.Errorf("error generating nonce: %w", err)
+	}
+	sealed := aead.Seal(nonce, nonce, []byte(value), nil)
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+// decryptField reverses encryptField
+func decryptField(aead cipher.AEAD, value string) (string, error) {
+	if value == "" {
+		return "", nil
+	}
+	sealed, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return "", fmt.Errorf("error decoding encrypted field: %w", err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", fmt.Errorf("error decrypting field: ciphertext too short")
+	}
+	plain, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf("error decrypting field: %w", err)
+	}
+	return string(plain), nil
+}
+
+// transformAddress applies fn to every string field of the address
+func transformAddress(a Address, fn func(string) (string, error)) (Address, error) {
+	fields := []*string{
+		&a.Country, &a.Name, &a.PostalCode, &a.City, &a.Street, &a.HouseNumber, &a.ApartmentNumber, &a.State,
+		&a.ContactPerson, &a.ContactPhone, &a.ContactEmail,
+	}
+	for _, field := range fields {
+		value, err := fn(*field)
+		if err != nil {
+			return Address{}, err
+		}
+		*field = value
+	}
+	return a, nil
+}
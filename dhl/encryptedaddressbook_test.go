@@ -0,0 +1,43 @@
+package dhl
+
+import (
+	"bytes"
+	"testing"
+)
+
+func TestEncryptedAddressBookRotateKey(t *testing.T) {
+	key := bytes.Repeat([]byte{1}, 32)
+	book := NewAddressBook()
+	encrypted, err := NewEncryptedAddressBook(key, book)
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+
+	address := Address{Name: "Jan Kowalski", City: "Warszawa", PostalCode: "00-001"}
+	if err := encrypted.Add(address); err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if stored := book.List()[0]; stored.Name == address.Name || stored.City == address.City {
+		t.Fatalf("address stored in plain text: %+v", stored)
+	}
+
+	if err := encrypted.RotateKey(bytes.Repeat([]byte{2}, 32)); err != nil {
+		t.Fatalf("rotate: %v", err)
+	}
+
+	found, err := encrypted.Search("warsz")
+	if err != nil {
+		t.Fatalf("search: %v", err)
+	}
+	if len(found) != 1 || found[0] != address {
+		t.Errorf("Search() = %+v, want %+v", found, address)
+	}
+
+	stale, err := NewEncryptedAddressBook(key, book)
+	if err != nil {
+		t.Fatalf("new: %v", err)
+	}
+	if _, err := stale.List(); err == nil {
+		t.Errorf("expected error decrypting with the old key")
+	}
+}
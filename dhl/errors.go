@@ -0,0 +1,128 @@
+package dhl
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// ErrShipmentAlreadyPickedUp is reported when cancelling a shipment the courier has already picked up
+var ErrShipmentAlreadyPickedUp = errors.New("shipment already picked up")
+
+// faultCodeShipmentPickedUp is the SOAP fault code reported by deleteShipments for picked up shipments
+const faultCodeShipmentPickedUp = 113
+
+// faultCodeInvalidCredentials is the SOAP fault code reported for wrong credentials
+const faultCodeInvalidCredentials = 100
+
+// SOAPFault represents a SOAP fault returned by the DHL24 API
+// Common fault codes:
+//   - 100: Invalid credentials
+//   - 101: Missing required parameter
+//   - 113: Shipment already picked up by the courier
+//   - 131: Product retrieval error (product not available for account)
+//
+// Use errors.As to inspect a fault returned by any client method
+type SOAPFault struct {
+	Code    string `xml:"faultcode"`
+	Message string `xml:"faultstring"`
+	// Detail is the raw content of the optional detail element
+	Detail string `xml:"detail"`
+}
+
+// soapFaultXML mirrors SOAPFault but keeps the detail element as inner XML
+type soapFaultXML struct {
+	Code    string `xml:"faultcode"`
+	Message string `xml:"faultstring"`
+	Detail  struct {
+		Inner string `xml:",innerxml"`
+	} `xml:"detail"`
+}
+
+// UnmarshalXML decodes a fault keeping nested detail elements intact
+func (f *SOAPFault) UnmarshalXML(d *xml.Decoder, start xml.StartElement) error {
+	var raw soapFaultXML
+	if err := d.DecodeElement(&raw, &start); err != nil {
+		return err
+	}
+	f.Code = strings.TrimSpace(raw.Code)
+	f.Message = strings.TrimSpace(raw.Message)
+	f.Detail = strings.TrimSpace(raw.Detail.Inner)
+	return nil
+}
+
+// Error implements the error interface
+func (f *SOAPFault) Error() string {
+	if f.Detail != "" {
+		return fmt.Sprintf("SOAP fault %s: %s (%s)", f.Code, f.Message, f.Detail)
+	}
+	return fmt.Sprintf("SOAP fault %s: %s", f.Code, f.Message)
+}
+
+// Is reports whether the fault matches a sentinel error, used by errors.Is
+func (f *SOAPFault) Is(target error) bool {
+	switch target {
+	case ErrSessionExpired:
+		return f.FaultCode() == faultCodeSessionExpired
+	case ErrShipmentAlreadyPickedUp:
+		return f.FaultCode() == faultCodeShipmentPickedUp
+	}
+	return false
+}
+
+// FaultCode returns the numeric DHL24 fault code, or 0 if the code is not numeric
+func (f *SOAPFault) FaultCode() int {
+	code := f.Code
+	if i := strings.LastIndex(code, ":"); i >= 0 {
+		code = code[i+1:]
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(code))
+	if err != nil {
+		return 0
+	}
+	return n
+}
+
+// soapFaultEnvelope is used to detect a SOAP fault in a response body
+type soapFaultEnvelope struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		Fault *SOAPFault `xml:"Fault"`
+	} `xml:"Body"`
+}
+
+// parseSOAPFault returns the SOAP fault contained in the response body, or nil if there is none
+func parseSOAPFault(body []byte) *SOAPFault {
+	var envelope soapFaultEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil
+	}
+	return envelope.Body.Fault
+}
+
+// itemFaultPattern matches a per-item error that starts with a fault code, e.g. "113: Shipment already picked up"
+var itemFaultPattern = regexp.MustCompile(`(?s)^(\d+)\s*[:.-]?\s*(.*)$`)
+
+// parseItemFault converts the error text of a single item in a batch response into a SOAPFault
+// The code is left empty when the text does not start with one
+func parseItemFault(text string) *SOAPFault {
+	text = strings.TrimSpace(text)
+	if m := itemFaultPattern.FindStringSubmatch(text); m != nil {
+		return &SOAPFault{Code: m[1], Message: m[2]}
+	}
+	return &SOAPFault{Message: text}
+}
+
+// ErrRequestTooLarge is returned when a SOAP request exceeds DHL24Config.MaxRequestBodySize
+type ErrRequestTooLarge struct {
+	ActualSize int64
+	MaxSize    int64
+}
+
+// Error implements the error interface
+func (e ErrRequestTooLarge) Error() string {
+	return fmt.Sprintf("request body too large: %d bytes (max %d bytes)", e.ActualSize, e.MaxSize)
+}
@@ -0,0 +1,82 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSOAPFaultReturnedAsError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `<?xml version="1.0" encoding="UTF-8"?>
+<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/">
+  <SOAP-ENV:Body>
+    <SOAP-ENV:Fault>
+      <faultcode>131</faultcode>
+      <faultstring>Product retrieval error</faultstring>
+      <detail><product>DW</product></detail>
+    </SOAP-ENV:Fault>
+  </SOAP-ENV:Body>
+</SOAP-ENV:Envelope>`)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{})
+	client.endpoint = server.URL
+
+	_, _, err := client.GetVersion(context.Background())
+	var fault *SOAPFault
+	if !errors.As(err, &fault) {
+		t.Fatalf("expected *SOAPFault, got %v", err)
+	}
+	if fault.FaultCode() != 131 || fault.Message != "Product retrieval error" {
+		t.Errorf("unexpected fault %+v", fault)
+	}
+	if fault.Detail != "<product>DW</product>" {
+		t.Errorf("Detail = %q", fault.Detail)
+	}
+}
+
+func TestCancelShipmentAlreadyPickedUp(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `<Envelope><Body><Fault><faultcode>113</faultcode><faultstring>Shipment already picked up</faultstring></Fault></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{})
+	client.endpoint = server.URL
+
+	ok, _, err := client.CancelShipment(context.Background(), "123")
+	if ok || !errors.Is(err, ErrShipmentAlreadyPickedUp) {
+		t.Fatalf("CancelShipment() = %v, %v, want ErrShipmentAlreadyPickedUp", ok, err)
+	}
+}
+
+func TestDeleteShipmentsMatchesResultsByID(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `<Envelope><Body><deleteShipmentsResponse><deleteShipmentsResult>
+<item><id>2</id><result>false</result><error>113: Shipment already picked up</error></item>
+<item><id>1</id><result>true</result></item>
+</deleteShipmentsResult></deleteShipmentsResponse></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL))
+
+	results, _, err := client.DeleteShipments(context.Background(), []ShipmentID{"1", "2", "3"})
+	if err != nil {
+		t.Fatalf("DeleteShipments() error = %v", err)
+	}
+	if len(results) != 3 || results[0].ShipmentID != "1" || !results[0].Success || results[0].Error != nil {
+		t.Fatalf("results = %+v, want shipment 1 cancelled first", results)
+	}
+	if results[1].Success || !errors.Is(results[1].Error, ErrShipmentAlreadyPickedUp) || results[1].Error.Message != "Shipment already picked up" {
+		t.Errorf("shipment 2 result = %+v, want ErrShipmentAlreadyPickedUp", results[1])
+	}
+	if results[2].Success || results[2].Error == nil {
+		t.Errorf("shipment 3 missing from response reported as %+v", results[2])
+	}
+}
@@ -0,0 +1,84 @@
+package dhl
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// ndjsonAddress is the export schema of AddressInfo with snake_case keys
+type ndjsonAddress struct {
+	Name            string `json:"name"`
+	PostalCode      string `json:"postal_code"`
+	City            string `json:"city"`
+	Street          string `json:"street"`
+	HouseNumber     string `json:"house_number"`
+	ApartmentNumber string `json:"apartment_number"`
+	ContactPerson   string `json:"contact_person"`
+	ContactPhone    string `json:"contact_phone"`
+	ContactEmail    string `json:"contact_email"`
+}
+
+// ndjsonShipment is the export schema of ShipmentBasicData with snake_case keys
+type ndjsonShipment struct {
+	ShipmentID  string        `json:"shipment_id"`
+	Created     string        `json:"created"`
+	Shipper     ndjsonAddress `json:"shipper"`
+	Receiver    ndjsonAddress `json:"receiver"`
+	OrderStatus string        `json:"order_status"`
+}
+
+// newNDJSONAddress converts a response address to the export schema
+func newNDJSONAddress(a AddressInfo) ndjsonAddress {
+	return ndjsonAddress{
+		Name:            a.Name,
+		PostalCode:      a.PostalCode,
+		City:            a.City,
+		Street:          a.Street,
+		HouseNumber:     a.HouseNumber,
+		ApartmentNumber: a.ApartmentNumber,
+		ContactPerson:   a.ContactPerson,
+		ContactPhone:    a.ContactPhone,
+		ContactEmail:    a.ContactEmail,
+	}
+}
+
+// newNDJSONShipment converts a shipment to the export schema
+func newNDJSONShipment(s ShipmentBasicData) ndjsonShipment {
+	return ndjsonShipment{
+		ShipmentID:  s.ShipmentID,
+		Created:     s.Created,
+		Shipper:     newNDJSONAddress(s.Shipper),
+		Receiver:    newNDJSONAddress(s.Receiver),
+		OrderStatus: s.OrderStatus,
+	}
+}
+
+// WriteShipmentsNDJSON writes shipments to w as newline-delimited JSON, one object per line
+// Keys are snake_case and independent of the DHL24 XML element names
+func WriteShipmentsNDJSON(w io.Writer, shipments []ShipmentBasicData) error {
+	encoder := json.NewEncoder(w)
+	for _, shipment := range shipments {
+		if err := encoder.Encode(newNDJSONShipment(shipment)); err != nil {
+			return fmt.Errorf("error writing shipment %s: %w", shipment.ShipmentID, err)
+		}
+	}
+	return nil
+}
+
+// StreamShipmentsNDJSON streams all shipments in the date range to w as NDJSON, e.g. for ELK or Splunk ingestion
+// Shipments are written as pages arrive, so memory use does not grow with the range size
+func StreamShipmentsNDJSON(ctx context.Context, dr DateRange, client DHLClient, w io.Writer) error {
+	// Cancelling stops the producer when writing fails before all pages are read
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	items, errs := client.StreamMyShipments(ctx, dr)
+	for shipment := range items {
+		if err := WriteShipmentsNDJSON(w, []ShipmentBasicData{shipment}); err != nil {
+			return err
+		}
+	}
+	return <-errs
+}
@@ -0,0 +1,37 @@
+package dhl
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+)
+
+func TestWriteShipmentsNDJSON(t *testing.T) {
+	shipments := []ShipmentBasicData{
+		{ShipmentID: "1", Receiver: AddressInfo{PostalCode: "00-001", ContactPhone: "123"}},
+		{ShipmentID: "2", OrderStatus: "DELIVERED"},
+	}
+
+	var buf bytes.Buffer
+	if err := WriteShipmentsNDJSON(&buf, shipments); err != nil {
+		t.Fatalf("write: %v", err)
+	}
+
+	lines := strings.Split(strings.TrimSuffix(buf.String(), "\n"), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("got %d lines, want 2:\n%s", len(lines), buf.String())
+	}
+
+	var first map[string]any
+	if err := json.Unmarshal([]byte(lines[0]), &first); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if first["shipment_id"] != "1" {
+		t.Errorf("shipment_id = %v", first["shipment_id"])
+	}
+	receiver, _ := first["receiver"].(map[string]any)
+	if receiver["postal_code"] != "00-001" || receiver["contact_phone"] != "123" {
+		t.Errorf("receiver = %v", receiver)
+	}
+}
@@ -0,0 +1,180 @@
+package dhl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/url"
+	"strconv"
+	"sync"
+	"time"
+)
+
+// ErrAddressNotFound is returned when a geocoder cannot resolve an address
+var ErrAddressNotFound = errors.New("address not found")
+
+// Geocoder resolves an address to geographic coordinates
+type Geocoder interface {
+	Geocode(ctx context.Context, address Address) (lat, lon float64, err error)
+}
+
+// Geocode resolves the address to latitude and longitude using the given geocoder
+func (a Address) Geocode(ctx context.Context, geocoder Geocoder) (float64, float64, error) {
+	return geocoder.Geocode(ctx, a)
+}
+
+// ReceiverLocation resolves the receiver address to latitude and longitude
+func (s ShipmentBasicData) ReceiverLocation(ctx context.Context, g Geocoder) (float64, float64, error) {
+	address := s.Receiver.ToAddress()
+	address.Country = "PL"
+	return g.Geocode(ctx, address)
+}
+
+// NominatimEndpoint is the OpenStreetMap Nominatim search API
+const NominatimEndpoint = "https://nominatim.openstreetmap.org/search"
+
+// NominatimGeocoder geocodes addresses with the free OpenStreetMap Nominatim API
+// Requests are limited to one per second as required by the Nominatim usage policy
+type NominatimGeocoder struct {
+	// UserAgent identifies the application, required by the Nominatim usage policy
+	UserAgent  string
+	HTTPClient *http.Client
+
+	mu          sync.Mutex
+	lastRequest time.Time
+}
+
+// NewNominatimGeocoder creates a Nominatim geocoder with the given application User-Agent
+func NewNominatimGeocoder(userAgent string) *NominatimGeocoder {
+	return &NominatimGeocoder{
+		UserAgent:  userAgent,
+		HTTPClient: &http.Client{Timeout: 10 * time.Second},
+	}
+}
+
+// Geocode implements Geocoder
+func (g *NominatimGeocoder) Geocode(ctx context.Context, address Address) (float64, float64, error) {
+	if err := g.wait(ctx); err != nil {
+		return 0, 0, err
+	}
+
+	query := url.Values{}
+	query.Set("format", "json")
+	query.Set("limit", "1")
+	query.Set("street", address.HouseNumber+" "+address.Street)
+	query.Set("city", address.City)
+	query.Set("postalcode", address.PostalCode)
+	if address.Country != "" {
+		query.Set("countrycodes", address.Country)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, NominatimEndpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("User-Agent", g.UserAgent)
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return 0, 0, fmt.Errorf("nominatim returned status %s", resp.Status)
+	}
+
+	var results []struct {
+		Lat string `json:"lat"`
+		Lon string `json:"lon"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return 0, 0, fmt.Errorf("error parsing response: %w", err)
+	}
+	if len(results) == 0 {
+		return 0, 0, ErrAddressNotFound
+	}
+
+	lat, err := strconv.ParseFloat(results[0].Lat, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error parsing latitude: %w", err)
+	}
+	lon, err := strconv.ParseFloat(results[0].Lon, 64)
+	if err != nil {
+		return 0, 0, fmt.Errorf("error parsing longitude: %w", err)
+	}
+
+	return lat, lon, nil
+}
+
+// wait enforces at most one request per second
+func (g *NominatimGeocoder) wait(ctx context.Context) error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if delay := time.Second - time.Since(g.lastRequest); delay > 0 {
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	g.lastRequest = time.Now()
+	return nil
+}
+
+// voivodeshipCapitals contains coordinates of voivodeship capitals used for region assignment
+var voivodeshipCapitals = map[string][2]float64{
+	"dolnośląskie":        {51.1079, 17.0385},
+	"kujawsko-pomorskie":  {53.0138, 18.5984},
+	"lubelskie":           {51.2465, 22.5684},
+	"lubuskie":            {52.2303, 15.2438},
+	"łódzkie":             {51.7592, 19.4560},
+	"małopolskie":         {50.0647, 19.9450},
+	"mazowieckie":         {52.2297, 21.0122},
+	"opolskie":            {50.6751, 17.9213},
+	"podkarpackie":        {50.0412, 21.9991},
+	"podlaskie":           {53.1325, 23.1688},
+	"pomorskie":           {54.3520, 18.6466},
+	"śląskie":             {50.2649, 19.0238},
+	"świętokrzyskie":      {50.8661, 20.6286},
+	"warmińsko-mazurskie": {53.7784, 20.4801},
+	"wielkopolskie":       {52.4064, 16.9252},
+	"zachodniopomorskie":  {53.4285, 14.5528},
+}
+
+// nearestVoivodeship returns the voivodeship whose capital is closest to the given point
+func nearestVoivodeship(lat, lon float64) string {
+	nearest := ""
+	best := math.MaxFloat64
+	for name, capital := range voivodeshipCapitals {
+		// Equirectangular approximation is sufficient for distances within Poland
+		x := (lon - capital[1]) * math.Cos((lat+capital[0])/2*math.Pi/180)
+		y := lat - capital[0]
+		if d := x*x + y*y; d < best {
+			best = d
+			nearest = name
+		}
+	}
+	return nearest
+}
+
+// GroupByNearestCapital groups shipments by the voivodeship whose capital is nearest to the receiver
+// This is not the voivodeship of the address: no border polygon data is bundled, so receivers near a border
+// can land in the neighbouring voivodeship, e.g. towns in eastern mazowieckie closer to Lublin than to Warsaw
+// Use Address.State from FillFromPostalCode where the exact voivodeship matters
+func GroupByNearestCapital(ctx context.Context, shipments []ShipmentBasicData, g Geocoder) (map[string][]ShipmentBasicData, error) {
+	groups := make(map[string][]ShipmentBasicData)
+	for _, shipment := range shipments {
+		lat, lon, err := shipment.ReceiverLocation(ctx, g)
+		if err != nil {
+			return nil, fmt.Errorf("error geocoding shipment %s: %w", shipment.ShipmentID, err)
+		}
+		region := nearestVoivodeship(lat, lon)
+		groups[region] = append(groups[region], shipment)
+	}
+	return groups, nil
+}
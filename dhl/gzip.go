@@ -0,0 +1,72 @@
+package dhl
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"net/http"
+)
+
+// WithGzipRequests compresses SOAP request bodies with gzip and sets Content-Encoding: gzip
+// The size limit and debug output apply to the uncompressed body, responses are read as usual
+// Compression is done by the innermost transport, so credentials are injected into the plain body first
+func WithGzipRequests(enabled bool) Option {
+	return func(c *Client) {
+		c.gzipRequests = enabled
+	}
+}
+
+// gzipTransport compresses request bodies before passing them to the base transport
+type gzipTransport struct {
+	Base http.RoundTripper
+}
+
+// RoundTrip implements http.RoundTripper
+func (t *gzipTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	base := t.Base
+	if base == nil {
+		base = http.DefaultTransport
+	}
+	if req.Body == nil {
+		return base.RoundTrip(req)
+	}
+
+	body, err := io.ReadAll(req.Body)
+	req.Body.Close()
+	if err != nil {
+		return nil, fmt.Errorf("error reading request body: %w", err)
+	}
+	compressed, err := gzipBody(body)
+	if err != nil {
+		return nil, err
+	}
+
+	outReq := req.Clone(req.Context())
+	outReq.Body = io.NopCloser(bytes.NewReader(compressed))
+	outReq.ContentLength = int64(len(compressed))
+	outReq.Header.Set("Content-Encoding", "gzip")
+	return base.RoundTrip(outReq)
+}
+
+// installGzipTransport puts gzipTransport below AuthenticatedTransport, or on top of the transport otherwise
+func (c *Client) installGzipTransport() {
+	if auth, ok := c.httpClient.Transport.(*AuthenticatedTransport); ok {
+		auth.Base = &gzipTransport{Base: auth.Base}
+		return
+	}
+	c.httpClient.Transport = &gzipTransport{Base: c.httpClient.Transport}
+}
+
+// gzipBody returns body compressed with gzip
+func gzipBody(body []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if _, err := zw.Write(body); err != nil {
+		return nil, fmt.Errorf("error compressing request: %w", err)
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("error compressing request: %w", err)
+	}
+	return buf.Bytes(), nil
+}
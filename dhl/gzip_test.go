@@ -0,0 +1,64 @@
+package dhl
+
+import (
+	"compress/gzip"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGzipRequests(t *testing.T) {
+	var encoding, requestBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		encoding = r.Header.Get("Content-Encoding")
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(zr)
+		requestBody = string(body)
+		io.WriteString(w, `<Envelope><Body><getVersionResponse><getVersionResult>2.5.0</getVersionResult></getVersionResponse></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL), WithGzipRequests(true))
+	version, _, err := client.GetVersion(context.Background())
+	if err != nil || version != "2.5.0" {
+		t.Fatalf("GetVersion = %q, %v", version, err)
+	}
+	if encoding != "gzip" || !strings.Contains(requestBody, "getVersion") {
+		t.Errorf("Content-Encoding = %q, body = %q", encoding, requestBody)
+	}
+}
+
+type staticCredentials struct{ username, password string }
+
+func (s staticCredentials) Username() string { return s.username }
+func (s staticCredentials) Password() string { return s.password }
+
+func TestGzipRequestsWithAutoRefreshCredentials(t *testing.T) {
+	var requestBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		zr, err := gzip.NewReader(r.Body)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		body, _ := io.ReadAll(zr)
+		requestBody = string(body)
+		io.WriteString(w, `<Envelope><Body><getVersionResponse><getVersionResult>2.5.0</getVersionResult></getVersionResponse></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{Username: "stale", Password: "stale"}, WithEndpoint(server.URL),
+		WithGzipRequests(true), WithAutoRefreshCredentials(staticCredentials{"fresh-user", "fresh-pass"}))
+	// The response does not match getMyShipmentsCount, only the request body matters here
+	_ = client.CheckAuth(context.Background())
+	if !strings.Contains(requestBody, "<username>fresh-user</username>") || !strings.Contains(requestBody, "<password>fresh-pass</password>") {
+		t.Errorf("credentials were not injected before compression: %q", requestBody)
+	}
+}
@@ -0,0 +1,128 @@
+// Package health provides HTTP health check handlers for services using the DHL24 client
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"dhl-test/dhl"
+)
+
+// checkTimeout bounds a single check against the DHL24 API
+const checkTimeout = 10 * time.Second
+
+// response is the JSON body returned by all handlers
+type response struct {
+	Status     string `json:"status"`
+	APIVersion string `json:"api_version,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// cachedCheck runs check at most once per ttl and serves the cached result in between
+// Concurrent requests after the cache expired share a single check, the mutex only guards the cached result
+type cachedCheck struct {
+	check func(ctx context.Context) (string, error)
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu      sync.Mutex
+	checked time.Time
+	version string
+	err     error
+}
+
+// ServeHTTP implements http.Handler
+func (h *cachedCheck) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	version, err := h.result(r.Context())
+
+	body := response{Status: "ok", APIVersion: version}
+	if err != nil {
+		body = response{Status: "error", Error: err.Error()}
+	}
+	writeResponse(w, body)
+}
+
+// writeResponse writes body as JSON, with 503 Service Unavailable unless the status is ok
+func writeResponse(w http.ResponseWriter, body response) {
+	status := http.StatusOK
+	if body.Status != "ok" {
+		status = http.StatusServiceUnavailable
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+// result returns the cached check result, running the check when the cache expired
+// The check is not cancelled when ctx is, other requests may be waiting for it
+func (h *cachedCheck) result(ctx context.Context) (string, error) {
+	if version, err, ok := h.cached(); ok {
+		return version, err
+	}
+
+	done := h.group.DoChan("check", func() (any, error) {
+		// A check that finished after the cache was read above is not repeated
+		if version, err, ok := h.cached(); ok {
+			return version, err
+		}
+
+		checkCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), checkTimeout)
+		defer cancel()
+
+		version, err := h.check(checkCtx)
+
+		h.mu.Lock()
+		h.version, h.err, h.checked = version, err, time.Now()
+		h.mu.Unlock()
+		return version, err
+	})
+
+	select {
+	case res := <-done:
+		return res.Val.(string), res.Err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	}
+}
+
+// cached returns the cached result if it has not expired
+func (h *cachedCheck) cached() (string, error, bool) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.checked.IsZero() || time.Since(h.checked) >= h.ttl {
+		return "", nil, false
+	}
+	return h.version, h.err, true
+}
+
+// NewHealthCheckHandler reports the API version, calling client.Ping at most once per ttl
+// Responds with 200 {"status":"ok","api_version":"..."} or 503 {"status":"error","error":"..."}
+func NewHealthCheckHandler(client dhl.DHLClient, ttl time.Duration) http.Handler {
+	return &cachedCheck{check: client.Ping, ttl: ttl}
+}
+
+// NewReadinessHandler reports whether the DHL24 API is reachable and accepts the configured credentials,
+// checking at most once per ttl
+func NewReadinessHandler(client dhl.DHLClient, ttl time.Duration) http.Handler {
+	return &cachedCheck{
+		check: func(ctx context.Context) (string, error) {
+			return "", client.CheckAuth(ctx)
+		},
+		ttl: ttl,
+	}
+}
+
+// NewLivenessHandler always responds with 200 {"status":"ok"} while the process serves HTTP
+// It makes no DHL24 call, so an API outage does not get the service restarted; use the readiness handler for that
+func NewLivenessHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeResponse(w, response{Status: "ok"})
+	})
+}
@@ -0,0 +1,116 @@
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"dhl-test/dhl"
+)
+
+// fakeClient counts Ping and CheckAuth calls, other DHLClient methods are not used by the handlers
+type fakeClient struct {
+	dhl.DHLClient
+
+	pings   atomic.Int32
+	auths   atomic.Int32
+	release chan struct{}
+	authErr error
+}
+
+func (f *fakeClient) Ping(ctx context.Context) (string, error) {
+	f.pings.Add(1)
+	if f.release != nil {
+		<-f.release
+	}
+	return "2.5.0", nil
+}
+
+func (f *fakeClient) CheckAuth(ctx context.Context) error {
+	f.auths.Add(1)
+	return f.authErr
+}
+
+func serve(h http.Handler) (int, response) {
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/", nil))
+
+	var body response
+	_ = json.NewDecoder(rec.Body).Decode(&body)
+	return rec.Code, body
+}
+
+func TestHealthCheckHandlerCachesResult(t *testing.T) {
+	client := &fakeClient{}
+	handler := NewHealthCheckHandler(client, time.Minute)
+
+	for range 3 {
+		code, body := serve(handler)
+		if code != http.StatusOK || body.Status != "ok" || body.APIVersion != "2.5.0" {
+			t.Fatalf("response = %d %+v", code, body)
+		}
+	}
+	if n := client.pings.Load(); n != 1 {
+		t.Errorf("Ping called %d times, want 1", n)
+	}
+}
+
+func TestHealthCheckHandlerSharesConcurrentCheck(t *testing.T) {
+	client := &fakeClient{release: make(chan struct{})}
+	handler := NewHealthCheckHandler(client, time.Minute)
+
+	var wg sync.WaitGroup
+	for range 5 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if code, _ := serve(handler); code != http.StatusOK {
+				t.Errorf("status = %d", code)
+			}
+		}()
+	}
+
+	for client.pings.Load() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	close(client.release)
+	wg.Wait()
+
+	if n := client.pings.Load(); n != 1 {
+		t.Errorf("Ping called %d times, want 1", n)
+	}
+}
+
+func TestHealthCheckHandlerReturnsWhenRequestIsCancelled(t *testing.T) {
+	client := &fakeClient{release: make(chan struct{})}
+	defer close(client.release)
+	handler := &cachedCheck{check: client.Ping, ttl: time.Minute}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if _, err := handler.result(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("result() error = %v, want deadline exceeded", err)
+	}
+}
+
+func TestReadinessHandlerReportsAuthError(t *testing.T) {
+	client := &fakeClient{authErr: errors.New("invalid credentials")}
+
+	code, body := serve(NewReadinessHandler(client, time.Minute))
+	if code != http.StatusServiceUnavailable || body.Status != "error" || body.Error != "invalid credentials" {
+		t.Errorf("response = %d %+v", code, body)
+	}
+}
+
+func TestLivenessHandlerMakesNoAPICall(t *testing.T) {
+	code, body := serve(NewLivenessHandler())
+	if code != http.StatusOK || body.Status != "ok" {
+		t.Errorf("response = %d %+v", code, body)
+	}
+}
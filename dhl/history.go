@@ -0,0 +1,151 @@
+package dhl
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"time"
+)
+
+// OrderStatus represents a shipment status
+type OrderStatus string
+
+const (
+	OrderStatusNew            OrderStatus = "NEW"
+	OrderStatusPickedUp       OrderStatus = "PICKED_UP"
+	OrderStatusInTransit      OrderStatus = "IN_TRANSIT"
+	OrderStatusOutForDelivery OrderStatus = "OUT_FOR_DELIVERY"
+	OrderStatusNotDelivered   OrderStatus = "NOT_DELIVERED"
+	OrderStatusDelivered      OrderStatus = "DELIVERED"
+	OrderStatusReturned       OrderStatus = "RETURNED"
+	OrderStatusUnknown        OrderStatus = "UNKNOWN"
+)
+
+// trackingTimeLayout is the timestamp format used in DHL24 tracking events
+const trackingTimeLayout = "2006-01-02 15:04:05"
+
+// SLATransitTime is the transit time above which a delivery is counted as delayed
+const SLATransitTime = 48 * time.Hour
+
+// eventStatuses maps DHL24 tracking event codes to shipment statuses
+var eventStatuses = map[string]OrderStatus{
+	"DWP":  OrderStatusPickedUp,
+	"SORT": OrderStatusInTransit,
+	"LP":   OrderStatusInTransit,
+	"KOR":  OrderStatusOutForDelivery,
+	"AWI":  OrderStatusNotDelivered,
+	"DOR":  OrderStatusDelivered,
+	"ZWR":  OrderStatusReturned,
+}
+
+// ShipmentHistoryEntry represents a single status transition of a shipment
+type ShipmentHistoryEntry struct {
+	Status    OrderStatus
+	Timestamp time.Time
+	Actor     string
+	Note      string
+}
+
+// Duration returns the time spent in this status until the next entry
+func (e ShipmentHistoryEntry) Duration(next ShipmentHistoryEntry) time.Duration {
+	return next.Timestamp.Sub(e.Timestamp)
+}
+
+// SLAReport summarizes transit times of delivered shipments
+type SLAReport struct {
+	AverageTransitHours float64
+	DelayedDeliveries   int
+}
+
+// GetShipmentHistory returns all status transitions of a shipment
+// DHL24 has no dedicated history operation, so the history is derived from
+// getTrackAndTraceInfo events, keeping only events that change the status
+// Use GetTrackAndTrace for the full event log including repeated statuses
+func (c *Client) GetShipmentHistory(ctx context.Context, shipmentID ShipmentID) ([]ShipmentHistoryEntry, error) {
+	info, _, err := c.getTrackAndTraceInfo(ctx, shipmentID)
+	if err != nil {
+		return nil, err
+	}
+
+	var history []ShipmentHistoryEntry
+	for _, event := range info.Events.Items {
+		status, ok := eventStatuses[event.Status]
+		if !ok {
+			status = OrderStatusUnknown
+		}
+		if len(history) > 0 && history[len(history)-1].Status == status {
+			continue
+		}
+
+		timestamp, err := time.ParseInLocation(trackingTimeLayout, event.Timestamp, time.Local)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing event timestamp %q: %w", event.Timestamp, err)
+		}
+
+		history = append(history, ShipmentHistoryEntry{
+			Status:    status,
+			Timestamp: timestamp,
+			Actor:     event.Terminal,
+			Note:      event.Description,
+		})
+	}
+
+	return history, nil
+}
+
+// HistoryAnalysis calculates transit time from the first history entry to each delivery
+func HistoryAnalysis(history []ShipmentHistoryEntry) SLAReport {
+	var report SLAReport
+	if len(history) == 0 {
+		return report
+	}
+
+	var total time.Duration
+	deliveries := 0
+	for _, entry := range history {
+		if entry.Status != OrderStatusDelivered {
+			continue
+		}
+		transit := history[0].Duration(entry)
+		total += transit
+		deliveries++
+		if transit > SLATransitTime {
+			report.DelayedDeliveries++
+		}
+	}
+
+	if deliveries > 0 {
+		report.AverageTransitHours = total.Hours() / float64(deliveries)
+	}
+
+	return report
+}
+
+// getTrackAndTraceInfo retrieves raw tracking events of a shipment
+func (c *Client) getTrackAndTraceInfo(ctx context.Context, shipmentID ShipmentID) (*TrackAndTraceInfo, *ResponseMeta, error) {
+	request := GetTrackAndTraceInfoRequest{
+		AuthData:   c.authData(),
+		ShipmentID: string(shipmentID),
+	}
+
+	reqBody, err := c.marshalSOAPRequest("getTrackAndTraceInfo", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#getTrackAndTraceInfo", "getTrackAndTraceInfo")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var envelope SOAPResponseEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, resp, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	if envelope.Body.GetTrackAndTraceInfoResponse == nil {
+		return nil, resp, fmt.Errorf("empty getTrackAndTraceInfo response")
+	}
+
+	return &envelope.Body.GetTrackAndTraceInfoResponse.Result, resp, nil
+}
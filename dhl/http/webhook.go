@@ -0,0 +1,193 @@
+// Package http provides DHL24-aware http.Handler implementations that can be mounted in an existing server
+package http
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	nethttp "net/http"
+	"strings"
+	"time"
+
+	"dhl-test/dhl"
+)
+
+// SignatureHeader carries the hex encoded HMAC-SHA256 of the request body
+const SignatureHeader = "X-DHL24-Signature"
+
+// maxWebhookBodySize limits the size of accepted webhook payloads
+const maxWebhookBodySize = 1 << 20
+
+// Webhook event types
+const (
+	EventStatusChange    = "statusChange"
+	EventDeliveryAttempt = "deliveryAttempt"
+	EventPickupConfirmed = "pickupConfirmed"
+)
+
+// ShipmentStatusEvent is sent when a shipment changes status
+type ShipmentStatusEvent struct {
+	ShipmentID string          `json:"shipmentId" xml:"shipmentId"`
+	Status     dhl.OrderStatus `json:"status" xml:"status"`
+	Timestamp  time.Time       `json:"timestamp" xml:"timestamp"`
+}
+
+// DeliveryAttemptEvent is sent when the courier fails to deliver a shipment
+type DeliveryAttemptEvent struct {
+	ShipmentID string    `json:"shipmentId" xml:"shipmentId"`
+	Attempt    int       `json:"attempt" xml:"attempt"`
+	Reason     string    `json:"reason" xml:"reason"`
+	Timestamp  time.Time `json:"timestamp" xml:"timestamp"`
+}
+
+// PickupEvent is sent when the courier confirms a pickup
+type PickupEvent struct {
+	ShipmentID string    `json:"shipmentId" xml:"shipmentId"`
+	Courier    string    `json:"courier" xml:"courier"`
+	Timestamp  time.Time `json:"timestamp" xml:"timestamp"`
+}
+
+// WebhookEventHandler receives parsed webhook events
+type WebhookEventHandler interface {
+	HandleStatusChange(ShipmentStatusEvent)
+	HandleDeliveryAttempt(DeliveryAttemptEvent)
+	HandlePickupConfirmed(PickupEvent)
+}
+
+// webhookEvent is the JSON payload, the event itself is decoded according to Type
+type webhookEvent struct {
+	Type string          `json:"type"`
+	Data json.RawMessage `json:"data"`
+}
+
+// soapWebhookEnvelope is the SOAP payload, exactly one body element is expected
+type soapWebhookEnvelope struct {
+	XMLName xml.Name `xml:"Envelope"`
+	Body    struct {
+		StatusChange    *ShipmentStatusEvent  `xml:"statusChange"`
+		DeliveryAttempt *DeliveryAttemptEvent `xml:"deliveryAttempt"`
+		PickupConfirmed *PickupEvent          `xml:"pickupConfirmed"`
+	} `xml:"Body"`
+}
+
+// WebhookHandler returns a handler that verifies the signature, parses a SOAP or JSON body
+// and dispatches the event to handler
+func WebhookHandler(secret string, handler WebhookEventHandler) nethttp.Handler {
+	return WebhookMiddleware(secret, nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			nethttp.Error(w, "error reading body", nethttp.StatusBadRequest)
+			return
+		}
+		if err := dispatch(body, handler); err != nil {
+			nethttp.Error(w, err.Error(), nethttp.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(nethttp.StatusNoContent)
+	}))
+}
+
+// WebhookMiddleware rejects requests without a valid signature and passes the rest to next
+// The body is restored so next can read it again
+func WebhookMiddleware(secret string, next nethttp.Handler) nethttp.Handler {
+	return nethttp.HandlerFunc(func(w nethttp.ResponseWriter, r *nethttp.Request) {
+		if r.Method != nethttp.MethodPost {
+			nethttp.Error(w, "method not allowed", nethttp.StatusMethodNotAllowed)
+			return
+		}
+		body, err := io.ReadAll(io.LimitReader(r.Body, maxWebhookBodySize+1))
+		if err != nil {
+			nethttp.Error(w, "error reading body", nethttp.StatusBadRequest)
+			return
+		}
+		if len(body) > maxWebhookBodySize {
+			nethttp.Error(w, "body too large", nethttp.StatusRequestEntityTooLarge)
+			return
+		}
+		if !VerifySignature(secret, body, r.Header.Get(SignatureHeader)) {
+			nethttp.Error(w, "invalid signature", nethttp.StatusUnauthorized)
+			return
+		}
+		r.Body = io.NopCloser(bytes.NewReader(body))
+		next.ServeHTTP(w, r)
+	})
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of body
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// VerifySignature reports whether signature matches body
+func VerifySignature(secret string, body []byte, signature string) bool {
+	expected, err := hex.DecodeString(strings.TrimPrefix(signature, "sha256="))
+	if err != nil {
+		return false
+	}
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(body)
+	return hmac.Equal(mac.Sum(nil), expected)
+}
+
+// dispatch parses body as SOAP when it starts with '<', otherwise as JSON
+func dispatch(body []byte, handler WebhookEventHandler) error {
+	if bytes.HasPrefix(bytes.TrimSpace(body), []byte("<")) {
+		return dispatchSOAP(body, handler)
+	}
+	return dispatchJSON(body, handler)
+}
+
+func dispatchJSON(body []byte, handler WebhookEventHandler) error {
+	var event webhookEvent
+	if err := json.Unmarshal(body, &event); err != nil {
+		return fmt.Errorf("error parsing webhook event: %w", err)
+	}
+	switch event.Type {
+	case EventStatusChange:
+		var data ShipmentStatusEvent
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return fmt.Errorf("error parsing %s event: %w", event.Type, err)
+		}
+		handler.HandleStatusChange(data)
+	case EventDeliveryAttempt:
+		var data DeliveryAttemptEvent
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return fmt.Errorf("error parsing %s event: %w", event.Type, err)
+		}
+		handler.HandleDeliveryAttempt(data)
+	case EventPickupConfirmed:
+		var data PickupEvent
+		if err := json.Unmarshal(event.Data, &data); err != nil {
+			return fmt.Errorf("error parsing %s event: %w", event.Type, err)
+		}
+		handler.HandlePickupConfirmed(data)
+	default:
+		return fmt.Errorf("unknown webhook event type %q", event.Type)
+	}
+	return nil
+}
+
+func dispatchSOAP(body []byte, handler WebhookEventHandler) error {
+	var envelope soapWebhookEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return fmt.Errorf("error parsing webhook event: %w", err)
+	}
+	switch {
+	case envelope.Body.StatusChange != nil:
+		handler.HandleStatusChange(*envelope.Body.StatusChange)
+	case envelope.Body.DeliveryAttempt != nil:
+		handler.HandleDeliveryAttempt(*envelope.Body.DeliveryAttempt)
+	case envelope.Body.PickupConfirmed != nil:
+		handler.HandlePickupConfirmed(*envelope.Body.PickupConfirmed)
+	default:
+		return fmt.Errorf("no webhook event in SOAP body")
+	}
+	return nil
+}
@@ -0,0 +1,101 @@
+package http
+
+import (
+	nethttp "net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const testSecret = "webhook-secret"
+
+// recordingHandler keeps the events dispatched by WebhookHandler
+type recordingHandler struct {
+	statusChanges []ShipmentStatusEvent
+	attempts      []DeliveryAttemptEvent
+	pickups       []PickupEvent
+}
+
+func (h *recordingHandler) HandleStatusChange(e ShipmentStatusEvent) {
+	h.statusChanges = append(h.statusChanges, e)
+}
+
+func (h *recordingHandler) HandleDeliveryAttempt(e DeliveryAttemptEvent) {
+	h.attempts = append(h.attempts, e)
+}
+
+func (h *recordingHandler) HandlePickupConfirmed(e PickupEvent) {
+	h.pickups = append(h.pickups, e)
+}
+
+func serveWebhook(handler *recordingHandler, body, signature string) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(nethttp.MethodPost, "/webhook", strings.NewReader(body))
+	if signature != "" {
+		req.Header.Set(SignatureHeader, signature)
+	}
+	rec := httptest.NewRecorder()
+	WebhookHandler(testSecret, handler).ServeHTTP(rec, req)
+	return rec
+}
+
+func TestWebhookHandlerAcceptsValidSignature(t *testing.T) {
+	body := `{"type":"statusChange","data":{"shipmentId":"123","status":"DELIVERED","timestamp":"2026-10-16T12:00:00Z"}}`
+	handler := &recordingHandler{}
+
+	rec := serveWebhook(handler, body, Sign(testSecret, []byte(body)))
+	if rec.Code != nethttp.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, nethttp.StatusNoContent, rec.Body)
+	}
+	if len(handler.statusChanges) != 1 || handler.statusChanges[0].ShipmentID != "123" {
+		t.Errorf("status changes = %+v, want one event for shipment 123", handler.statusChanges)
+	}
+}
+
+func TestWebhookHandlerAcceptsSOAPBody(t *testing.T) {
+	body := `<Envelope><Body><pickupConfirmed><shipmentId>123</shipmentId><courier>Jan</courier></pickupConfirmed></Body></Envelope>`
+	handler := &recordingHandler{}
+
+	rec := serveWebhook(handler, body, "sha256="+Sign(testSecret, []byte(body)))
+	if rec.Code != nethttp.StatusNoContent {
+		t.Fatalf("status = %d, want %d: %s", rec.Code, nethttp.StatusNoContent, rec.Body)
+	}
+	if len(handler.pickups) != 1 || handler.pickups[0].Courier != "Jan" {
+		t.Errorf("pickups = %+v, want one event from courier Jan", handler.pickups)
+	}
+}
+
+func TestWebhookHandlerRejectsInvalidSignatures(t *testing.T) {
+	body := `{"type":"statusChange","data":{"shipmentId":"123","status":"DELIVERED"}}`
+	tests := []struct {
+		name      string
+		body      string
+		signature string
+	}{
+		{"tampered body", strings.Replace(body, "123", "456", 1), Sign(testSecret, []byte(body))},
+		{"wrong secret", body, Sign("other-secret", []byte(body))},
+		{"not hex", body, "not-a-signature"},
+		{"missing header", body, ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			handler := &recordingHandler{}
+			rec := serveWebhook(handler, tt.body, tt.signature)
+			if rec.Code != nethttp.StatusUnauthorized {
+				t.Errorf("status = %d, want %d", rec.Code, nethttp.StatusUnauthorized)
+			}
+			if len(handler.statusChanges) != 0 {
+				t.Errorf("status changes = %+v, want none", handler.statusChanges)
+			}
+		})
+	}
+}
+
+func TestWebhookHandlerRejectsGet(t *testing.T) {
+	req := httptest.NewRequest(nethttp.MethodGet, "/webhook", nil)
+	rec := httptest.NewRecorder()
+	WebhookHandler(testSecret, &recordingHandler{}).ServeHTTP(rec, req)
+	if rec.Code != nethttp.StatusMethodNotAllowed {
+		t.Errorf("status = %d, want %d", rec.Code, nethttp.StatusMethodNotAllowed)
+	}
+}
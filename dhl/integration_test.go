@@ -0,0 +1,126 @@
+//go:build integration
+
+package dhl
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+)
+
+// TestMain skips the integration suite when sandbox credentials are not provided
+func TestMain(m *testing.M) {
+	if os.Getenv("DHL24_USERNAME") == "" {
+		fmt.Println("Skipping integration tests: DHL24_USERNAME is not set")
+		os.Exit(0)
+	}
+	os.Exit(m.Run())
+}
+
+func TestCreateShipmentSandbox(t *testing.T) {
+	config, err := LoadConfigFromEnv()
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	config.DHL24.Sandbox = true
+
+	client := NewClient(&config.DHL24)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	shipment := ShipmentItem{
+		Shipper: Address{
+			Name:         "Integration Test Sp. z o.o.",
+			PostalCode:   "02495",
+			City:         "Warszawa",
+			Street:       "Osmańska",
+			HouseNumber:  "2",
+			ContactPhone: "223300000",
+			ContactEmail: "shipper@example.com",
+		},
+		Receiver: Address{
+			Country:      "PL",
+			Name:         "Jan Kowalski",
+			PostalCode:   "30001",
+			City:         "Kraków",
+			Street:       "Długa",
+			HouseNumber:  "15",
+			ContactPhone: "501234567",
+			ContactEmail: "receiver@example.com",
+		},
+		PieceList: PieceList{
+			Items: []Piece{{Type: "PACKAGE", Quantity: 1, Weight: 1}},
+		},
+		Payment: Payment{
+			PaymentType:   "BANK_TRANSFER",
+			PayerType:     PaymentTypeShipper,
+			AccountNumber: config.DHL24.AccountNumber,
+			PaymentMethod: "BANK_TRANSFER",
+		},
+		Service:      Service{Product: ProductParcel},
+		ShipmentDate: time.Now().AddDate(0, 0, 1).Format("2006-01-02"),
+		Content:      "integration test",
+	}
+
+	created, _, err := client.CreateShipment(ctx, shipment)
+	if err != nil {
+		t.Fatalf("create shipment: %v", err)
+	}
+	if created.ShipmentID == "" {
+		t.Fatal("expected non-empty ShipmentID")
+	}
+
+	t.Cleanup(func() {
+		cleanupCtx, cleanupCancel := context.WithTimeout(context.Background(), 30*time.Second)
+		defer cleanupCancel()
+		if _, _, err := client.CancelShipment(cleanupCtx, ShipmentID(created.ShipmentID)); err != nil {
+			t.Errorf("cancel shipment %s: %v", created.ShipmentID, err)
+		}
+	})
+
+	label, _, err := client.GetLabel(ctx, ShipmentID(created.ShipmentID), LabelTypeBLP)
+	if err != nil {
+		t.Fatalf("get label: %v", err)
+	}
+	if len(label) == 0 {
+		t.Fatal("expected non-empty label")
+	}
+}
+
+func TestVersionCacheRefreshSandbox(t *testing.T) {
+	config, err := LoadConfigFromEnv()
+	if err != nil {
+		t.Fatalf("load config: %v", err)
+	}
+	config.DHL24.Sandbox = true
+
+	client := NewClient(&config.DHL24)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	// Simulate a cached version from before a server upgrade
+	client.versionCache.set(client.endpoint, VersionInfo{Version: "0.0.0"})
+
+	info, _, err := client.GetVersionInfo(ctx)
+	if err != nil {
+		t.Fatalf("get cached version: %v", err)
+	}
+	if info.Version != "0.0.0" {
+		t.Fatalf("expected cached version, got %q", info.Version)
+	}
+
+	client.ForceCacheRefresh("getVersion")
+
+	info, resp, err := client.GetVersionInfo(ctx)
+	if err != nil {
+		t.Fatalf("get version: %v", err)
+	}
+	if resp == nil || info.Version == "0.0.0" {
+		t.Fatalf("expected version from API after refresh, got %q", info.Version)
+	}
+	t.Logf("API version %s", info.Version)
+}
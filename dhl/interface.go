@@ -0,0 +1,22 @@
+package dhl
+
+import "context"
+
+// DHLClient is the set of DHL24 operations implemented by Client
+// Depend on it instead of *Client to substitute a fake in tests
+type DHLClient interface {
+	GetVersion(ctx context.Context) (string, *ResponseMeta, error)
+	CreateShipments(ctx context.Context, reqs []ShipmentRequest) ([]CreatedShipment, *ResponseMeta, error)
+	CreateShipment(ctx context.Context, req ShipmentRequest) (*CreatedShipment, *ResponseMeta, error)
+	GetMyShipments(ctx context.Context, createdFrom, createdTo string, offset int) ([]ShipmentBasicData, *ResponseMeta, error)
+	StreamMyShipments(ctx context.Context, dr DateRange) (<-chan ShipmentBasicData, <-chan error)
+	GetLabel(ctx context.Context, shipmentID ShipmentID, labelType LabelType) ([]byte, *ResponseMeta, error)
+	CancelShipment(ctx context.Context, shipmentID ShipmentID) (bool, *ResponseMeta, error)
+	GetTrackAndTrace(ctx context.Context, shipmentID ShipmentID) ([]TrackingEvent, *ResponseMeta, error)
+	GetShipmentStatus(ctx context.Context, shipmentID ShipmentID) (OrderStatus, error)
+	GetShipmentByWaybillNumber(ctx context.Context, waybillNumber string) (*ShipmentBasicData, error)
+	Ping(ctx context.Context) (string, error)
+	CheckAuth(ctx context.Context) error
+}
+
+var _ DHLClient = (*Client)(nil)
@@ -0,0 +1,105 @@
+package dhl
+
+import (
+	"context"
+	"fmt"
+	"iter"
+	"time"
+)
+
+// ShipmentIterator traverses shipments page by page, fetching the next page when the current one is exhausted
+//
+//	it := client.NewShipmentIterator(ctx, "2024-01-01", "2024-01-31")
+//	for it.Next() {
+//		shipment := it.Shipment()
+//	}
+//	if err := it.Err(); err != nil { ... }
+type ShipmentIterator struct {
+	ctx    context.Context
+	client *Client
+	dr     DateRange
+
+	page  []ShipmentBasicData
+	index int
+	token string
+	done  bool
+	err   error
+}
+
+// NewShipmentIterator returns an iterator over shipments created between createdFrom and createdTo (YYYY-MM-DD)
+// No request is made until Next is called
+func (c *Client) NewShipmentIterator(ctx context.Context, createdFrom, createdTo string) *ShipmentIterator {
+	it := &ShipmentIterator{ctx: ctx, client: c}
+
+	from, err := time.Parse("2006-01-02", createdFrom)
+	if err != nil {
+		it.err = fmt.Errorf("invalid createdFrom %q: %w", createdFrom, err)
+		return it
+	}
+	to, err := time.Parse("2006-01-02", createdTo)
+	if err != nil {
+		it.err = fmt.Errorf("invalid createdTo %q: %w", createdTo, err)
+		return it
+	}
+
+	it.dr = DateRange{From: from, To: to}
+	return it
+}
+
+// Next advances to the next shipment, fetching a page if needed
+// Returns false when all shipments are read or an error occurred, see Err
+func (it *ShipmentIterator) Next() bool {
+	if it.err != nil {
+		return false
+	}
+
+	for it.index+1 >= len(it.page) {
+		if it.done {
+			return false
+		}
+		if !it.fetch() {
+			return false
+		}
+	}
+
+	it.index++
+	return true
+}
+
+// Shipment returns the current shipment, valid after Next returned true
+func (it *ShipmentIterator) Shipment() ShipmentBasicData {
+	return it.page[it.index]
+}
+
+// Err returns the error that stopped the iteration, or nil
+func (it *ShipmentIterator) Err() error {
+	return it.err
+}
+
+// All returns a range-over-func sequence of the remaining shipments, check Err after the loop
+func (it *ShipmentIterator) All() iter.Seq[ShipmentBasicData] {
+	return func(yield func(ShipmentBasicData) bool) {
+		for it.Next() {
+			if !yield(it.Shipment()) {
+				return
+			}
+		}
+	}
+}
+
+// fetch loads the next page, reporting whether the iteration can continue
+func (it *ShipmentIterator) fetch() bool {
+	page, err := it.client.GetMyShipmentsPage(it.ctx, it.dr, it.token)
+	if err != nil {
+		it.err = err
+		return false
+	}
+
+	it.page = page.Items
+	it.index = -1
+	it.token = page.NextToken()
+	if it.token == "" {
+		it.done = true
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+package dhl
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestShipmentIteratorFetchesPagesLazily(t *testing.T) {
+	requests := 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		requests++
+		var items strings.Builder
+		if strings.Contains(string(body), "<offset>100</offset>") {
+			items.WriteString(`<item><shipmentId>101</shipmentId></item>`)
+		} else {
+			for id := 1; id <= myShipmentsPageSize; id++ {
+				fmt.Fprintf(&items, `<item><shipmentId>%d</shipmentId></item>`, id)
+			}
+		}
+		fmt.Fprintf(w, `<Envelope><Body><getMyShipmentsResponse><getMyShipmentsResult>%s</getMyShipmentsResult></getMyShipmentsResponse></Body></Envelope>`, items.String())
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL))
+
+	it := client.NewShipmentIterator(context.Background(), "2024-01-01", "2024-01-31")
+	if !it.Next() || it.Shipment().ShipmentID != "1" || requests != 1 {
+		t.Fatalf("first shipment not read from first page")
+	}
+
+	var ids []string
+	for shipment := range it.All() {
+		ids = append(ids, shipment.ShipmentID)
+	}
+	if err := it.Err(); err != nil {
+		t.Fatalf("iterate: %v", err)
+	}
+	if len(ids) != myShipmentsPageSize || ids[len(ids)-1] != "101" || requests != 2 {
+		t.Errorf("ids = %v after %d page requests", ids, requests)
+	}
+}
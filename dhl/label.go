@@ -0,0 +1,154 @@
+package dhl
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LabelResult contains a decoded label document with its metadata
+type LabelResult struct {
+	ShipmentID ShipmentID
+	LabelType  LabelType
+	Name       string
+	MimeType   string
+	Data       []byte
+}
+
+// AggregateError collects errors of multiple failed attempts
+type AggregateError struct {
+	Errors []error
+}
+
+// Error implements the error interface
+func (e *AggregateError) Error() string {
+	messages := make([]string, len(e.Errors))
+	for i, err := range e.Errors {
+		messages[i] = err.Error()
+	}
+	return fmt.Sprintf("all %d attempts failed: %s", len(e.Errors), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the collected errors for errors.Is and errors.As
+func (e *AggregateError) Unwrap() []error {
+	return e.Errors
+}
+
+// Bytes returns the decoded label data
+func (l Label) Bytes() ([]byte, error) {
+	data, err := base64.StdEncoding.DecodeString(l.LabelData)
+	if err != nil {
+		return nil, fmt.Errorf("error decoding label data: %w", err)
+	}
+	return data, nil
+}
+
+// GetLabelWithFallback tries each label type in order and returns the first successful result
+// If all types fail, an *AggregateError lists the error of each attempt
+func (c *Client) GetLabelWithFallback(ctx context.Context, shipmentID ShipmentID, formats ...LabelType) (*LabelResult, error) {
+	if len(formats) == 0 {
+		return nil, fmt.Errorf("no label formats specified")
+	}
+
+	var failed AggregateError
+	for _, format := range formats {
+		label, _, err := c.getLabel(ctx, shipmentID, format)
+		if err == nil {
+			var data []byte
+			if data, err = label.Bytes(); err == nil {
+				return &LabelResult{
+					ShipmentID: shipmentID,
+					LabelType:  format,
+					Name:       label.LabelName,
+					MimeType:   label.LabelMimeType,
+					Data:       data,
+				}, nil
+			}
+		}
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		failed.Errors = append(failed.Errors, fmt.Errorf("label type %s: %w", format, err))
+	}
+
+	return nil, &failed
+}
+
+// GetLabelDefaultFormat retrieves a label using DHL24Config.DefaultLabelFormat
+// If no default is configured, BLP is tried first and LP as fallback
+func (c *Client) GetLabelDefaultFormat(ctx context.Context, shipmentID ShipmentID) (*LabelResult, error) {
+	if c.config.DefaultLabelFormat != "" {
+		return c.GetLabelWithFallback(ctx, shipmentID, c.config.DefaultLabelFormat)
+	}
+	return c.GetLabelWithFallback(ctx, shipmentID, LabelTypeBLP, LabelTypeLP)
+}
+
+// LabelError reports a label that was downloaded but could not be decoded or written
+type LabelError struct {
+	ShipmentID ShipmentID
+	// Op is "decode" or "write"
+	Op   string
+	Path string
+	Err  error
+}
+
+// Error implements the error interface
+func (e *LabelError) Error() string {
+	if e.Path != "" {
+		return fmt.Sprintf("label %s: %s %s: %v", e.ShipmentID, e.Op, e.Path, e.Err)
+	}
+	return fmt.Sprintf("label %s: %s: %v", e.ShipmentID, e.Op, e.Err)
+}
+
+// Unwrap returns the underlying error
+func (e *LabelError) Unwrap() error {
+	return e.Err
+}
+
+// SaveLabel downloads a label and writes it to destPath atomically
+// The label is written to a temporary file in the same directory and renamed, so destPath never holds a partial label
+// Decode and write failures are returned as *LabelError, API errors are returned unchanged
+func (c *Client) SaveLabel(ctx context.Context, shipmentID ShipmentID, labelType LabelType, destPath string) error {
+	label, _, err := c.getLabel(ctx, shipmentID, labelType)
+	if err != nil {
+		return err
+	}
+
+	data, err := label.Bytes()
+	if err != nil {
+		return &LabelError{ShipmentID: shipmentID, Op: "decode", Err: err}
+	}
+
+	if err := writeFileAtomic(destPath, data); err != nil {
+		return &LabelError{ShipmentID: shipmentID, Op: "write", Path: destPath, Err: err}
+	}
+	return nil
+}
+
+// writeFileAtomic writes data to a temporary file next to path and renames it to path
+func writeFileAtomic(path string, data []byte) error {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*.tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
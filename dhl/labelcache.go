@@ -0,0 +1,125 @@
+package dhl
+
+import (
+	"bytes"
+	"container/list"
+	"sync"
+	"time"
+)
+
+// CacheStats contains label cache usage counters
+type CacheStats struct {
+	Hits      int64
+	Misses    int64
+	Evictions int64
+}
+
+// labelCacheKey identifies a cached label
+type labelCacheKey struct {
+	shipmentID string
+	labelType  LabelType
+}
+
+// labelCacheEntry holds cached label bytes
+type labelCacheEntry struct {
+	key     labelCacheKey
+	data    []byte
+	expires time.Time
+}
+
+// labelCache is a thread-safe LRU cache for label documents
+type labelCache struct {
+	mu         sync.Mutex
+	maxEntries int
+	ttl        time.Duration
+	order      *list.List
+	entries    map[labelCacheKey]*list.Element
+	stats      CacheStats
+}
+
+// WithLabelCache enables caching of GetLabel results
+// Least recently used labels are evicted when maxEntries is reached
+func WithLabelCache(maxEntries int, ttl time.Duration) Option {
+	return func(c *Client) {
+		c.labelCache = &labelCache{
+			maxEntries: maxEntries,
+			ttl:        ttl,
+			order:      list.New(),
+			entries:    make(map[labelCacheKey]*list.Element),
+		}
+	}
+}
+
+// ClearLabelCache removes all cached labels
+func (c *Client) ClearLabelCache() {
+	if c.labelCache == nil {
+		return
+	}
+
+	c.labelCache.mu.Lock()
+	defer c.labelCache.mu.Unlock()
+
+	c.labelCache.order.Init()
+	c.labelCache.entries = make(map[labelCacheKey]*list.Element)
+}
+
+// LabelCacheStats returns label cache counters, zero if the cache is disabled
+func (c *Client) LabelCacheStats() CacheStats {
+	if c.labelCache == nil {
+		return CacheStats{}
+	}
+
+	c.labelCache.mu.Lock()
+	defer c.labelCache.mu.Unlock()
+
+	return c.labelCache.stats
+}
+
+// get returns a copy of the cached label bytes if present and not expired
+func (lc *labelCache) get(key labelCacheKey) ([]byte, bool) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	elem, ok := lc.entries[key]
+	if !ok {
+		lc.stats.Misses++
+		return nil, false
+	}
+
+	entry := elem.Value.(*labelCacheEntry)
+	if time.Now().After(entry.expires) {
+		lc.order.Remove(elem)
+		delete(lc.entries, key)
+		lc.stats.Misses++
+		return nil, false
+	}
+
+	lc.order.MoveToFront(elem)
+	lc.stats.Hits++
+	return bytes.Clone(entry.data), true
+}
+
+// set stores a copy of the label bytes, evicting the least recently used entry when full
+func (lc *labelCache) set(key labelCacheKey, data []byte) {
+	lc.mu.Lock()
+	defer lc.mu.Unlock()
+
+	data = bytes.Clone(data)
+	expires := time.Now().Add(lc.ttl)
+	if elem, ok := lc.entries[key]; ok {
+		entry := elem.Value.(*labelCacheEntry)
+		entry.data = data
+		entry.expires = expires
+		lc.order.MoveToFront(elem)
+		return
+	}
+
+	lc.entries[key] = lc.order.PushFront(&labelCacheEntry{key: key, data: data, expires: expires})
+
+	for lc.maxEntries > 0 && lc.order.Len() > lc.maxEntries {
+		oldest := lc.order.Back()
+		lc.order.Remove(oldest)
+		delete(lc.entries, oldest.Value.(*labelCacheEntry).key)
+		lc.stats.Evictions++
+	}
+}
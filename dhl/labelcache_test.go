@@ -0,0 +1,25 @@
+package dhl
+
+import (
+	"testing"
+	"time"
+)
+
+func TestLabelCacheReturnsCopies(t *testing.T) {
+	client := NewClient(&DHL24Config{}, WithLabelCache(10, time.Minute))
+	key := labelCacheKey{shipmentID: "1", labelType: LabelTypeLP}
+
+	data := []byte("label")
+	client.labelCache.set(key, data)
+	data[0] = 'X'
+
+	got, ok := client.labelCache.get(key)
+	if !ok || string(got) != "label" {
+		t.Fatalf("get() = %q, %v, want %q", got, ok, "label")
+	}
+	got[0] = 'X'
+
+	if again, _ := client.labelCache.get(key); string(again) != "label" {
+		t.Errorf("get() after modifying a returned label = %q, want %q", again, "label")
+	}
+}
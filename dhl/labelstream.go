@@ -0,0 +1,200 @@
+package dhl
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+)
+
+// maxLabelStreamPrefix limits how much of the response before labelData is kept for SOAP fault parsing
+const maxLabelStreamPrefix = 64 << 10
+
+// GetLabelStream retrieves a label and returns a reader of the decoded label data without buffering the response
+// The caller must close the returned reader
+// The response body is not parsed beyond the labelData element, so no LabelResult metadata is available
+// Label caching and debug response files do not apply to streamed labels
+func (c *Client) GetLabelStream(ctx context.Context, shipmentID ShipmentID, labelType LabelType) (io.ReadCloser, error) {
+	request := GetLabelsRequest{
+		AuthData: c.authData(),
+		ItemsToPrint: ItemsToPrint{
+			Items: []ItemToPrint{{LabelType: labelType, ShipmentID: string(shipmentID)}},
+		},
+	}
+
+	reqBody, err := c.marshalSOAPRequest("getLabels", request)
+	if err != nil {
+		return nil, err
+	}
+
+	// The deadline covers reading the stream, it is cancelled when the reader is closed
+	cancel := func() {}
+	if timeout := c.requestTimeout("getLabels"); timeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+	}
+
+	releaseSlot, err := c.acquireRequestSlot(ctx)
+	if err != nil {
+		cancel()
+		return nil, err
+	}
+	release := func() {
+		releaseSlot()
+		cancel()
+	}
+
+	resp, err := c.sendRequest(ctx, reqBody, c.namespace()+"#getLabels", "getLabels")
+	if err != nil {
+		release()
+		return nil, err
+	}
+	body := &releasingBody{ReadCloser: resp.Body, release: release}
+
+	reader := bufio.NewReader(body)
+	prefix, found, err := skipToElement(reader, "labelData")
+	if err != nil {
+		body.Close()
+		return nil, fmt.Errorf("error reading response: %w", err)
+	}
+	if !found {
+		body.Close()
+		if fault := parseSOAPFault(prefix); fault != nil {
+			c.notifyFault("getLabels", fault)
+			return nil, fault
+		}
+		return nil, fmt.Errorf("empty getLabels response")
+	}
+
+	return struct {
+		io.Reader
+		io.Closer
+	}{
+		Reader: base64.NewDecoder(base64.StdEncoding, &elementTextReader{r: reader}),
+		Closer: body,
+	}, nil
+}
+
+// releasingBody frees the concurrency slot of a streamed request when the body is closed
+type releasingBody struct {
+	io.ReadCloser
+	release func()
+	once    sync.Once
+}
+
+// Close closes the body and releases the request slot
+func (b *releasingBody) Close() error {
+	err := b.ReadCloser.Close()
+	b.once.Do(b.release)
+	return err
+}
+
+// GetLabelStreamToFile streams a label directly to a file at path
+// The file is removed if the download fails
+func (c *Client) GetLabelStreamToFile(ctx context.Context, shipmentID ShipmentID, path string, labelType LabelType) error {
+	stream, err := c.GetLabelStream(ctx, shipmentID, labelType)
+	if err != nil {
+		return err
+	}
+	defer stream.Close()
+
+	file, err := os.Create(path)
+	if err != nil {
+		return fmt.Errorf("error creating label file: %w", err)
+	}
+
+	if _, err := io.Copy(file, stream); err != nil {
+		file.Close()
+		os.Remove(path)
+		return fmt.Errorf("error writing label file: %w", err)
+	}
+
+	if err := file.Close(); err != nil {
+		os.Remove(path)
+		return fmt.Errorf("error writing label file: %w", err)
+	}
+	return nil
+}
+
+// skipToElement reads r until just after the start tag of the element with the given local name
+// Returns up to maxLabelStreamPrefix bytes read so far, which is enough to hold a SOAP fault
+func skipToElement(r *bufio.Reader, localName string) ([]byte, bool, error) {
+	var prefix []byte
+	keep := func(b []byte) {
+		if len(prefix) < maxLabelStreamPrefix {
+			prefix = append(prefix, b...)
+		}
+	}
+
+	for {
+		text, err := r.ReadBytes('<')
+		keep(text)
+		if err == io.EOF {
+			return prefix, false, nil
+		}
+		if err != nil {
+			return prefix, false, err
+		}
+
+		tag, err := r.ReadBytes('>')
+		keep(tag)
+		if err == io.EOF {
+			return prefix, false, nil
+		}
+		if err != nil {
+			return prefix, false, err
+		}
+
+		name := bytes.TrimSuffix(tag, []byte(">"))
+		if bytes.HasSuffix(name, []byte("/")) {
+			continue
+		}
+		if i := bytes.IndexAny(name, " \t\r\n"); i >= 0 {
+			name = name[:i]
+		}
+		if i := bytes.IndexByte(name, ':'); i >= 0 {
+			name = name[i+1:]
+		}
+		if string(name) == localName {
+			return prefix, true, nil
+		}
+	}
+}
+
+// elementTextReader reads character data up to the next tag
+type elementTextReader struct {
+	r    *bufio.Reader
+	done bool
+}
+
+// Read implements io.Reader
+func (e *elementTextReader) Read(p []byte) (int, error) {
+	if e.done {
+		return 0, io.EOF
+	}
+	if e.r.Buffered() == 0 {
+		if _, err := e.r.Peek(1); err != nil {
+			if err == io.EOF {
+				err = io.ErrUnexpectedEOF
+			}
+			return 0, err
+		}
+	}
+
+	buf, _ := e.r.Peek(min(len(p), e.r.Buffered()))
+	n := len(buf)
+	if i := bytes.IndexByte(buf, '<'); i >= 0 {
+		n = i
+		e.done = true
+	}
+	copy(p, buf[:n])
+	e.r.Discard(n)
+
+	if n == 0 && e.done {
+		return 0, io.EOF
+	}
+	return n, nil
+}
@@ -0,0 +1,79 @@
+package dhl
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"slices"
+	"text/tabwriter"
+)
+
+// ShipmentList is a list of shipments returned by getMyShipments
+type ShipmentList []ShipmentBasicData
+
+// Print writes shipments in a compact one-line format
+func (l ShipmentList) Print(w io.Writer) {
+	_, _ = fmt.Fprintf(w, "Found %d shipment(s):\n", len(l))
+	for _, shipment := range l {
+		_, _ = fmt.Fprintf(w, "%-30s | %s | %-20s | %s\n", shipment.ShipmentID, shipment.Created, shipment.OrderStatus, shipment.Receiver.Name)
+	}
+}
+
+// PrintTable writes shipments as a table with aligned columns
+func (l ShipmentList) PrintTable(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(tw, "SHIPMENT ID\tCREATED\tSTATUS\tRECEIVER\tCITY")
+	for _, shipment := range l {
+		_, _ = fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n", shipment.ShipmentID, shipment.Created, shipment.OrderStatus, shipment.Receiver.Name, shipment.Receiver.City)
+	}
+	_ = tw.Flush()
+}
+
+// FilterByStatus returns shipments with the given order status
+func (l ShipmentList) FilterByStatus(status string) ShipmentList {
+	var filtered ShipmentList
+	for _, shipment := range l {
+		if shipment.OrderStatus == status {
+			filtered = append(filtered, shipment)
+		}
+	}
+	return filtered
+}
+
+// SortByDate returns a copy of the list sorted by creation time, oldest first
+// Shipments with an unparsable creation time are placed first
+func (l ShipmentList) SortByDate() ShipmentList {
+	sorted := slices.Clone(l)
+	slices.SortStableFunc(sorted, func(a, b ShipmentBasicData) int {
+		return a.CreatedAtOrZero().Compare(b.CreatedAtOrZero())
+	})
+	return sorted
+}
+
+// WriteCSV writes shipments as CSV with a header row, using the same columns as StreamMyShipmentsToWriter
+func (l ShipmentList) WriteCSV(w io.Writer) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(shipmentCSVHeader); err != nil {
+		return err
+	}
+	for _, shipment := range l {
+		if err := writer.Write(shipmentCSVRecord(shipment)); err != nil {
+			return fmt.Errorf("error writing shipment %s: %w", shipment.ShipmentID, err)
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteJSON writes shipments as a JSON array, indented with two spaces when indent is set
+func (l ShipmentList) WriteJSON(w io.Writer, indent bool) error {
+	encoder := json.NewEncoder(w)
+	if indent {
+		encoder.SetIndent("", "  ")
+	}
+	if l == nil {
+		l = ShipmentList{}
+	}
+	return encoder.Encode(l)
+}
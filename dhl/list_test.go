@@ -0,0 +1,70 @@
+package dhl
+
+import (
+	"bytes"
+	"encoding/csv"
+	"strings"
+	"testing"
+)
+
+func TestShipmentListSortAndFilter(t *testing.T) {
+	list := ShipmentList{
+		{ShipmentID: "3", Created: "2026-10-03 08:00:00", OrderStatus: "DELIVERED"},
+		{ShipmentID: "1", Created: "2026-10-01 08:00:00", OrderStatus: "NEW"},
+		{ShipmentID: "2", Created: "2026-10-02 08:00:00", OrderStatus: "DELIVERED"},
+	}
+
+	sorted := list.SortByDate()
+	for i, want := range []string{"1", "2", "3"} {
+		if sorted[i].ShipmentID != want {
+			t.Fatalf("sorted[%d] = %s, want %s", i, sorted[i].ShipmentID, want)
+		}
+	}
+	if list[0].ShipmentID != "3" {
+		t.Error("SortByDate modified the original list")
+	}
+
+	delivered := sorted.FilterByStatus("DELIVERED")
+	if len(delivered) != 2 || delivered[0].ShipmentID != "2" {
+		t.Errorf("unexpected filtered list: %+v", delivered)
+	}
+}
+
+func TestShipmentListPrintTable(t *testing.T) {
+	list := ShipmentList{
+		{ShipmentID: "1234567890", Created: "2026-10-01 08:00:00", OrderStatus: "NEW", Receiver: AddressInfo{Name: "Jan", City: "Kraków"}},
+	}
+
+	var buf bytes.Buffer
+	list.PrintTable(&buf)
+
+	lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected header and one row, got:\n%s", buf.String())
+	}
+	if strings.Index(lines[0], "CREATED") != strings.Index(lines[1], "2026-10-01") {
+		t.Errorf("columns are not aligned:\n%s", buf.String())
+	}
+}
+
+func TestShipmentListWriteCSV(t *testing.T) {
+	list := ShipmentList{
+		{ShipmentID: "1", Receiver: AddressInfo{Name: `Firma "Kot", Sp. z o.o.`}},
+	}
+
+	var buf bytes.Buffer
+	if err := list.WriteCSV(&buf); err != nil {
+		t.Fatalf("write csv: %v", err)
+	}
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatalf("read csv: %v", err)
+	}
+	if len(records) != 2 || len(records[1]) != len(shipmentCSVHeader) {
+		t.Fatalf("unexpected records: %q", records)
+	}
+	if got := records[1][12]; got != `Firma "Kot", Sp. z o.o.` {
+		t.Errorf("receiver name = %q", got)
+	}
+}
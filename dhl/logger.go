@@ -0,0 +1,27 @@
+package dhl
+
+// Logger is a structured logging interface compatible with log/slog style loggers
+// Args are alternating key/value pairs
+type Logger interface {
+	Debug(msg string, args ...any)
+	Info(msg string, args ...any)
+	Warn(msg string, args ...any)
+	Error(msg string, args ...any)
+}
+
+// WithLogger sets the logger used for warnings and debug messages, nothing is logged by default
+func WithLogger(l Logger) Option {
+	return func(c *Client) {
+		if l != nil {
+			c.logger = l
+		}
+	}
+}
+
+// noopLogger discards all messages
+type noopLogger struct{}
+
+func (noopLogger) Debug(string, ...any) {}
+func (noopLogger) Info(string, ...any)  {}
+func (noopLogger) Warn(string, ...any)  {}
+func (noopLogger) Error(string, ...any) {}
@@ -0,0 +1,126 @@
+// Package metrics provides Prometheus instrumentation for the DHL24 client
+// It is a separate package so the client does not depend on Prometheus
+package metrics
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+
+	"dhl-test/dhl"
+)
+
+// MetricsMiddleware records HTTP round trips per DHL24 operation and HTTP status:
+// dhl24_http_requests_total and dhl24_http_request_duration_seconds
+// Failed round trips are counted with code "error", each retry attempt is a separate round trip
+// If the metrics cannot be registered with reg they are still collected but not exported,
+// use NewMetricsMiddleware to get the registration error
+func MetricsMiddleware(reg prometheus.Registerer) dhl.Middleware {
+	m, _ := NewMetricsMiddleware(reg)
+	return m
+}
+
+// NewMetricsMiddleware is MetricsMiddleware that reports registration conflicts
+// Collectors already registered with the same labels, e.g. by another client, are reused
+func NewMetricsMiddleware(reg prometheus.Registerer) (dhl.Middleware, error) {
+	requests, errRequests := register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "dhl24_http_requests_total",
+		Help: "Number of HTTP round trips to the DHL24 API by operation and HTTP status code",
+	}, []string{"operation", "code"}))
+	duration, errDuration := register(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "dhl24_http_request_duration_seconds",
+		Help:    "Duration of HTTP round trips to the DHL24 API by operation",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"operation"}))
+
+	middleware := func(next dhl.RoundTripFunc) dhl.RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			operation := operationName(req)
+			start := time.Now()
+			resp, err := next(req)
+			duration.WithLabelValues(operation).Observe(time.Since(start).Seconds())
+
+			code := "error"
+			if err == nil {
+				code = strconv.Itoa(resp.StatusCode)
+			}
+			requests.WithLabelValues(operation, code).Inc()
+
+			return resp, err
+		}
+	}
+	return middleware, errors.Join(errRequests, errDuration)
+}
+
+// WithPrometheusMetrics records SOAP operation metrics in the default Prometheus registry:
+// <namespace>_request_duration_seconds by operation and HTTP status code ("0" when no response was received)
+// and <namespace>_soap_faults_total by operation and DHL24 fault code
+// If the metrics cannot be registered they are still collected but not exported,
+// use NewPrometheusMetrics to get the registration error
+func WithPrometheusMetrics(namespace string) dhl.Option {
+	opt, _ := NewPrometheusMetrics(prometheus.DefaultRegisterer, namespace)
+	return opt
+}
+
+// NewPrometheusMetrics is WithPrometheusMetrics with a custom registry that reports registration conflicts
+func NewPrometheusMetrics(reg prometheus.Registerer, namespace string) (dhl.Option, error) {
+	duration, errDuration := register(reg, prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "request_duration_seconds",
+		Help:      "Duration of DHL24 SOAP requests by operation and HTTP status code",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"operation", "code"}))
+	faults, errFaults := register(reg, prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "soap_faults_total",
+		Help:      "Number of SOAP faults returned by the DHL24 API by operation and fault code",
+	}, []string{"operation", "fault_code"}))
+
+	opt := dhl.WithResultHook(func(operation string, meta *dhl.ResponseMeta, err error) {
+		code := strconv.Itoa(meta.StatusCode)
+		duration.WithLabelValues(operation, code).Observe(float64(meta.DurationMs) / 1000)
+
+		var fault *dhl.SOAPFault
+		if errors.As(err, &fault) {
+			faults.WithLabelValues(operation, strconv.Itoa(fault.FaultCode())).Inc()
+		}
+	})
+	return opt, errors.Join(errDuration, errFaults)
+}
+
+// MetricsHandler exposes the default Prometheus registry, serve it on /metrics
+func MetricsHandler() http.Handler {
+	return promhttp.Handler()
+}
+
+// register registers the collector or returns the one already registered under the same name and labels
+// On any other error the unregistered collector is returned together with the error
+func register[C prometheus.Collector](reg prometheus.Registerer, c C) (C, error) {
+	err := reg.Register(c)
+	if err == nil {
+		return c, nil
+	}
+
+	var already prometheus.AlreadyRegisteredError
+	if errors.As(err, &already) {
+		if existing, ok := already.ExistingCollector.(C); ok {
+			return existing, nil
+		}
+	}
+	return c, fmt.Errorf("error registering DHL24 metrics: %w", err)
+}
+
+// operationName returns the operation from the SOAPAction header, e.g. "getVersion" from "...#getVersion"
+func operationName(req *http.Request) string {
+	action := strings.Trim(req.Header.Get("SOAPAction"), `"`)
+	if i := strings.LastIndex(action, "#"); i >= 0 {
+		return action[i+1:]
+	}
+	return action
+}
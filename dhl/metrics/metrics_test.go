@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	dto "github.com/prometheus/client_model/go"
+
+	"dhl-test/dhl"
+)
+
+const faultResponse = `<Envelope><Body><Fault><faultcode>113</faultcode><faultstring>Already picked up</faultstring></Fault></Body></Envelope>`
+
+// gather returns the gathered metric families by name
+func gather(t *testing.T, reg *prometheus.Registry) map[string]*dto.MetricFamily {
+	t.Helper()
+	families, err := reg.Gather()
+	if err != nil {
+		t.Fatalf("gather: %v", err)
+	}
+	byName := make(map[string]*dto.MetricFamily)
+	for _, mf := range families {
+		byName[mf.GetName()] = mf
+	}
+	return byName
+}
+
+// labels returns the label values of a metric by name
+func labels(m *dto.Metric) map[string]string {
+	values := make(map[string]string)
+	for _, pair := range m.GetLabel() {
+		values[pair.GetName()] = pair.GetValue()
+	}
+	return values
+}
+
+func TestMiddlewareAndResultHookMetricsTogether(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, faultResponse)
+	}))
+	defer server.Close()
+
+	reg := prometheus.NewRegistry()
+	option, err := NewPrometheusMetrics(reg, "dhl24")
+	if err != nil {
+		t.Fatalf("register result metrics: %v", err)
+	}
+	middleware, err := NewMetricsMiddleware(reg)
+	if err != nil {
+		t.Fatalf("register middleware metrics: %v", err)
+	}
+
+	client := dhl.NewClient(&dhl.DHL24Config{}, dhl.WithEndpoint(server.URL), option, dhl.WithMiddleware(middleware))
+	if _, _, err := client.GetVersion(context.Background()); err == nil {
+		t.Fatal("expected fault error")
+	}
+
+	families := gather(t, reg)
+
+	faults := families["dhl24_soap_faults_total"]
+	if faults == nil || len(faults.GetMetric()) != 1 {
+		t.Fatalf("unexpected fault metrics: %v", faults)
+	}
+	if got := labels(faults.GetMetric()[0]); got["operation"] != "getVersion" || got["fault_code"] != "113" {
+		t.Errorf("fault labels = %v", got)
+	}
+
+	duration := families["dhl24_request_duration_seconds"]
+	if duration == nil || labels(duration.GetMetric()[0])["code"] != "500" {
+		t.Errorf("unexpected duration metrics: %v", duration)
+	}
+
+	requests := families["dhl24_http_requests_total"]
+	if requests == nil || requests.GetMetric()[0].GetCounter().GetValue() != 1 {
+		t.Errorf("unexpected request metrics: %v", requests)
+	}
+}
+
+func TestMetricsReusedAcrossClients(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	for i := 0; i < 2; i++ {
+		if _, err := NewPrometheusMetrics(reg, "dhl24"); err != nil {
+			t.Fatalf("register %d: %v", i, err)
+		}
+		if _, err := NewMetricsMiddleware(reg); err != nil {
+			t.Fatalf("register middleware %d: %v", i, err)
+		}
+	}
+}
+
+func TestMetricsRegistrationConflict(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	reg.MustRegister(prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "dhl24",
+		Name:      "soap_faults_total",
+		Help:      "conflicting labels",
+	}, []string{"code"}))
+
+	option, err := NewPrometheusMetrics(reg, "dhl24")
+	if err == nil {
+		t.Fatal("expected registration error")
+	}
+	if option == nil {
+		t.Fatal("expected a usable option despite the conflict")
+	}
+}
@@ -0,0 +1,85 @@
+package dhl
+
+import (
+	"net/http"
+	"slices"
+	"time"
+)
+
+// RoundTripFunc sends an HTTP request and returns the response
+type RoundTripFunc func(*http.Request) (*http.Response, error)
+
+// Middleware wraps the HTTP round trip of API calls, e.g. to log, retry or measure requests
+type Middleware func(next RoundTripFunc) RoundTripFunc
+
+// WithMiddleware adds middlewares around the HTTP round trip of API calls
+// The first middleware is the outermost one, repeated options append to the chain
+func WithMiddleware(m ...Middleware) Option {
+	return func(c *Client) {
+		c.middlewares = append(c.middlewares, m...)
+	}
+}
+
+// roundTrip sends the request through the middleware chain and the HTTP client
+func (c *Client) roundTrip(req *http.Request) (*http.Response, error) {
+	next := RoundTripFunc(c.httpClient.Do)
+	for _, m := range slices.Backward(c.middlewares) {
+		next = m(next)
+	}
+	return next(req)
+}
+
+// LoggingMiddleware logs every request with its SOAP action, HTTP status and duration
+func LoggingMiddleware(logger Logger) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req)
+			duration := time.Since(start)
+
+			action := req.Header.Get("SOAPAction")
+			if err != nil {
+				logger.Warn("DHL24 HTTP request failed", "action", action, "duration", duration, "error", err)
+				return resp, err
+			}
+			logger.Debug("DHL24 HTTP request", "action", action, "status", resp.StatusCode, "duration", duration)
+			return resp, nil
+		}
+	}
+}
+
+// RetryMiddleware retries network errors and HTTP 429, 502, 503 and 504 according to the policy
+// Unlike WithRetryPolicy it retries each HTTP round trip, so it also covers calls made by other middlewares
+func RetryMiddleware(policy RetryPolicy) Middleware {
+	return func(next RoundTripFunc) RoundTripFunc {
+		return func(req *http.Request) (*http.Response, error) {
+			for attempt := 0; ; attempt++ {
+				resp, err := next(req)
+
+				transient := err != nil && req.Context().Err() == nil
+				if err == nil && slices.Contains(retryableStatusCodes, resp.StatusCode) {
+					transient = true
+				}
+				if !transient || attempt+1 >= policy.MaxAttempts || req.GetBody == nil {
+					return resp, err
+				}
+				if resp != nil {
+					resp.Body.Close()
+				}
+
+				select {
+				case <-time.After(policy.delay(attempt)):
+				case <-req.Context().Done():
+					return nil, req.Context().Err()
+				}
+
+				body, err := req.GetBody()
+				if err != nil {
+					return nil, err
+				}
+				req = req.Clone(req.Context())
+				req.Body = body
+			}
+		}
+	}
+}
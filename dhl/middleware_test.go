@@ -0,0 +1,55 @@
+package dhl
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMiddlewareChainOrderAndRetry(t *testing.T) {
+	const response = `<Envelope><Body><getVersionResponse><getVersionResult>2.5.0</getVersionResult></getVersionResponse></Body></Envelope>`
+	var bodies []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		bodies = append(bodies, string(body))
+		if len(bodies) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, response)
+	}))
+	defer server.Close()
+
+	var order []string
+	trace := func(name string) Middleware {
+		return func(next RoundTripFunc) RoundTripFunc {
+			return func(req *http.Request) (*http.Response, error) {
+				order = append(order, name)
+				return next(req)
+			}
+		}
+	}
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL),
+		WithMiddleware(trace("outer"), RetryMiddleware(RetryPolicy{MaxAttempts: 2, InitialDelay: time.Millisecond})),
+		WithMiddleware(trace("inner")),
+	)
+
+	version, _, err := client.GetVersion(context.Background())
+	if err != nil {
+		t.Fatalf("get version: %v", err)
+	}
+	if version != "2.5.0" {
+		t.Errorf("version = %q", version)
+	}
+	if got := strings.Join(order, ","); got != "outer,inner,inner" {
+		t.Errorf("middleware order = %s", got)
+	}
+	if len(bodies) != 2 || bodies[0] != bodies[1] || !strings.Contains(bodies[1], "getVersion") {
+		t.Errorf("retried request body differs: %q", bodies)
+	}
+}
@@ -0,0 +1,260 @@
+// Package mock provides a scripted DHL24 SOAP server for unit tests
+package mock
+
+import (
+	"bytes"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"dhl-test/dhl"
+)
+
+// responseHeader and responseFooter wrap a response element in a SOAP envelope
+const (
+	responseHeader = `<?xml version="1.0" encoding="UTF-8"?>` +
+		`<SOAP-ENV:Envelope xmlns:SOAP-ENV="http://schemas.xmlsoap.org/soap/envelope/"><SOAP-ENV:Body>`
+	responseFooter = `</SOAP-ENV:Body></SOAP-ENV:Envelope>`
+)
+
+// expectation is a scripted request and its response
+type expectation struct {
+	operation string
+	// check validates the operation element of the request body, nil accepts any body
+	check    func(body []byte) error
+	status   int
+	response string
+}
+
+// MockServer is an httptest server answering DHL24 SOAP requests with scripted responses
+// Expectations are matched in the order they were added, each one answers a single request
+type MockServer struct {
+	server *httptest.Server
+
+	mu           sync.Mutex
+	expectations []expectation
+	errs         []error
+}
+
+// NewMockServer starts a mock server, point the client at it with dhl.WithEndpoint(server.URL())
+func NewMockServer() *MockServer {
+	m := &MockServer{}
+	m.server = httptest.NewServer(http.HandlerFunc(m.handle))
+	return m
+}
+
+// URL returns the endpoint URL of the server
+func (m *MockServer) URL() string {
+	return m.server.URL
+}
+
+// Close shuts down the server
+func (m *MockServer) Close() {
+	m.server.Close()
+}
+
+// ExpectGetVersion expects a getVersion request and answers with version
+func (m *MockServer) ExpectGetVersion(version string) {
+	m.expect("getVersion", nil, dhl.GetVersionResponse{Version: version})
+}
+
+// ExpectCreateShipments expects a createShipments request containing exactly req and answers with resp
+// The receiver country must be set in req when the client fills in its default country
+func (m *MockServer) ExpectCreateShipments(req dhl.ShipmentRequest, resp dhl.CreateShipmentsResponse) {
+	check := func(body []byte) error {
+		var got struct {
+			Shipments dhl.Shipments `xml:"shipments"`
+		}
+		if err := xml.Unmarshal(body, &got); err != nil {
+			return err
+		}
+		if len(got.Shipments.Items) != 1 {
+			return fmt.Errorf("expected 1 shipment, got %d", len(got.Shipments.Items))
+		}
+		return compareXML(req, got.Shipments.Items[0])
+	}
+	m.expect("createShipments", check, resp)
+}
+
+// ExpectGetMyShipments expects a getMyShipments request for the date range and answers with result
+func (m *MockServer) ExpectGetMyShipments(createdFrom, createdTo string, result dhl.GetMyShipmentsResult) {
+	check := func(body []byte) error {
+		var got struct {
+			CreatedFrom string `xml:"createdFrom"`
+			CreatedTo   string `xml:"createdTo"`
+		}
+		if err := xml.Unmarshal(body, &got); err != nil {
+			return err
+		}
+		if got.CreatedFrom != createdFrom || got.CreatedTo != createdTo {
+			return fmt.Errorf("expected range %s - %s, got %s - %s", createdFrom, createdTo, got.CreatedFrom, got.CreatedTo)
+		}
+		return nil
+	}
+	m.expect("getMyShipments", check, dhl.GetMyShipmentsResponse{Result: result})
+}
+
+// ExpectDeleteShipments expects a deleteShipments request for shipmentIDs and answers with resp
+func (m *MockServer) ExpectDeleteShipments(shipmentIDs []string, resp dhl.DeleteShipmentsResponse) {
+	check := func(body []byte) error {
+		var got struct {
+			Shipments dhl.ShipmentIDs `xml:"shipments"`
+		}
+		if err := xml.Unmarshal(body, &got); err != nil {
+			return err
+		}
+		return compareXML(dhl.ShipmentIDs{Items: shipmentIDs}, got.Shipments)
+	}
+	m.expect("deleteShipments", check, resp)
+}
+
+// ExpectGetLabels expects a getLabels request and answers with resp
+func (m *MockServer) ExpectGetLabels(resp dhl.GetLabelsResponse) {
+	m.expect("getLabels", nil, resp)
+}
+
+// ExpectFault expects a request for operation and answers with a SOAP fault
+func (m *MockServer) ExpectFault(operation, code, message string) {
+	var buf bytes.Buffer
+	buf.WriteString("<SOAP-ENV:Fault><faultcode>")
+	_ = xml.EscapeText(&buf, []byte(code))
+	buf.WriteString("</faultcode><faultstring>")
+	_ = xml.EscapeText(&buf, []byte(message))
+	buf.WriteString("</faultstring></SOAP-ENV:Fault>")
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectations = append(m.expectations, expectation{
+		operation: operation,
+		status:    http.StatusInternalServerError,
+		response:  responseHeader + buf.String() + responseFooter,
+	})
+}
+
+// Verify fails t for unexpected or mismatched requests and for expectations that were not met
+func (m *MockServer) Verify(t testing.TB) {
+	t.Helper()
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for _, err := range m.errs {
+		t.Error(err)
+	}
+	for _, e := range m.expectations {
+		t.Errorf("expected %s request was not received", e.operation)
+	}
+}
+
+// expect adds an expectation answering with resp encoded as the operation response element
+func (m *MockServer) expect(operation string, check func(body []byte) error, resp any) {
+	var data bytes.Buffer
+	start := xml.StartElement{Name: xml.Name{Local: operation + "Response"}}
+	if err := xml.NewEncoder(&data).EncodeElement(resp, start); err != nil {
+		panic(fmt.Sprintf("mock: encoding %s response: %v", operation, err))
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.expectations = append(m.expectations, expectation{
+		operation: operation,
+		check:     check,
+		status:    http.StatusOK,
+		response:  responseHeader + data.String() + responseFooter,
+	})
+}
+
+// handle matches a request against the next expectation
+func (m *MockServer) handle(w http.ResponseWriter, r *http.Request) {
+	body, err := io.ReadAll(r.Body)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	operation, content, err := parseRequest(body)
+	if err != nil {
+		m.fail(w, fmt.Errorf("invalid SOAP request: %w", err))
+		return
+	}
+
+	m.mu.Lock()
+	if len(m.expectations) == 0 {
+		m.mu.Unlock()
+		m.fail(w, fmt.Errorf("unexpected %s request", operation))
+		return
+	}
+	e := m.expectations[0]
+	m.expectations = m.expectations[1:]
+	m.mu.Unlock()
+
+	if operation != e.operation {
+		m.fail(w, fmt.Errorf("expected %s request, got %s", e.operation, operation))
+		return
+	}
+	if action := r.Header.Get("SOAPAction"); !strings.HasSuffix(action, "#"+operation) {
+		m.fail(w, fmt.Errorf("%s: unexpected SOAPAction %q", operation, action))
+		return
+	}
+	if e.check != nil {
+		if err := e.check(content); err != nil {
+			m.fail(w, fmt.Errorf("%s: %w", operation, err))
+			return
+		}
+	}
+
+	w.Header().Set("Content-Type", "text/xml; charset=utf-8")
+	w.WriteHeader(e.status)
+	io.WriteString(w, e.response)
+}
+
+// fail records err and answers with HTTP 400 so the client call fails too
+func (m *MockServer) fail(w http.ResponseWriter, err error) {
+	m.mu.Lock()
+	m.errs = append(m.errs, err)
+	m.mu.Unlock()
+	http.Error(w, err.Error(), http.StatusBadRequest)
+}
+
+// parseRequest returns the operation name and raw operation element of a SOAP request
+func parseRequest(body []byte) (string, []byte, error) {
+	var envelope struct {
+		Body struct {
+			Content struct {
+				XMLName xml.Name
+				Inner   []byte `xml:",innerxml"`
+			} `xml:",any"`
+		} `xml:"Body"`
+	}
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return "", nil, err
+	}
+
+	content := envelope.Body.Content
+	if content.XMLName.Local == "" {
+		return "", nil, fmt.Errorf("empty SOAP body")
+	}
+	element := "<" + content.XMLName.Local + ">" + string(content.Inner) + "</" + content.XMLName.Local + ">"
+	return content.XMLName.Local, []byte(element), nil
+}
+
+// compareXML reports a mismatch between the XML encodings of want and got
+func compareXML(want, got any) error {
+	wantXML, err := xml.Marshal(want)
+	if err != nil {
+		return err
+	}
+	gotXML, err := xml.Marshal(got)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(wantXML, gotXML) {
+		return fmt.Errorf("request mismatch\nwant: %s\n got: %s", wantXML, gotXML)
+	}
+	return nil
+}
@@ -0,0 +1,61 @@
+package mock
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"dhl-test/dhl"
+)
+
+func TestMockServerScriptedResponses(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	shipment := dhl.ShipmentRequest{
+		Receiver:     dhl.Address{Country: "PL", Name: "Receiver"},
+		Service:      dhl.Service{Product: "AH"},
+		ShipmentDate: "2026-01-02",
+	}
+	server.ExpectGetVersion("2.5.0")
+	server.ExpectCreateShipments(shipment, dhl.CreateShipmentsResponse{
+		Result: dhl.CreateShipmentsResult{Items: []dhl.CreatedShipment{{ShipmentID: "123"}}},
+	})
+	server.ExpectFault("deleteShipments", "100", "Invalid credentials")
+
+	client := dhl.NewClient(&dhl.DHL24Config{}, dhl.WithEndpoint(server.URL()))
+	ctx := context.Background()
+
+	version, _, err := client.GetVersion(ctx)
+	if err != nil || version != "2.5.0" {
+		t.Fatalf("GetVersion = %q, %v", version, err)
+	}
+
+	created, _, err := client.CreateShipment(ctx, shipment)
+	if err != nil || created.ShipmentID != "123" {
+		t.Fatalf("CreateShipment = %+v, %v", created, err)
+	}
+
+	var fault *dhl.SOAPFault
+	if _, _, err := client.CancelShipment(ctx, "123"); !errors.As(err, &fault) || fault.FaultCode() != 100 {
+		t.Fatalf("CancelShipment error = %v, want fault 100", err)
+	}
+
+	server.Verify(t)
+}
+
+func TestMockServerReportsMismatch(t *testing.T) {
+	server := NewMockServer()
+	defer server.Close()
+
+	server.ExpectGetMyShipments("2026-01-01", "2026-01-31", dhl.GetMyShipmentsResult{})
+
+	client := dhl.NewClient(&dhl.DHL24Config{}, dhl.WithEndpoint(server.URL()))
+	if _, _, err := client.GetMyShipments(context.Background(), "2026-02-01", "2026-02-28", 0); err == nil {
+		t.Fatal("expected error for mismatched request")
+	}
+
+	if len(server.errs) != 1 {
+		t.Errorf("recorded errors = %v, want 1", server.errs)
+	}
+}
@@ -0,0 +1,102 @@
+package dhl
+
+import (
+	"net/http"
+	"time"
+)
+
+// Option configures optional Client behaviour
+type Option func(*Client)
+
+// WithDefaultCountry sets the receiver country used when Address.Country is empty (default "PL")
+func WithDefaultCountry(cc CountryCode) Option {
+	return func(c *Client) {
+		c.defaultCountry = cc
+	}
+}
+
+// WithDeadlineWarning calls handler when threshold fraction (e.g. 0.8) of the time until the context
+// deadline has elapsed while a request is still in flight
+// The handler runs in its own goroutine and must be safe for concurrent use
+func WithDeadlineWarning(threshold float64, handler func(operation string, remaining time.Duration)) Option {
+	return func(c *Client) {
+		c.deadlineThreshold = threshold
+		c.deadlineHandler = handler
+	}
+}
+
+// WithHTTPClient replaces the HTTP client used for API calls, e.g. to inject a custom transport
+// Apply it before options that wrap the transport, such as WithAutoRefreshCredentials
+// The client is copied, so options wrapping the transport do not change httpClient;
+// its Timeout, if set, limits each round trip in addition to the API call timeout; a nil client is ignored
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		if httpClient == nil {
+			return
+		}
+		hc := *httpClient
+		c.httpClient = &hc
+	}
+}
+
+// WithTimeout sets the timeout of an API call including retries (default 15 seconds), 0 disables it
+// Per-operation timeouts in DHL24Config take precedence
+func WithTimeout(timeout time.Duration) Option {
+	return func(c *Client) {
+		c.timeout = timeout
+	}
+}
+
+// WithEndpoint overrides the URL requests are sent to, e.g. a proxy or a mock server
+// The SOAP namespace still follows DHL24Config.Sandbox or WithSandbox
+func WithEndpoint(endpoint string) Option {
+	return func(c *Client) {
+		c.endpoint = endpoint
+	}
+}
+
+// WithSandbox targets the DHL24 sandbox endpoint regardless of DHL24Config.Sandbox
+func WithSandbox() Option {
+	return func(c *Client) {
+		c.endpoint = SandboxEndpoint
+		c.sandbox = true
+	}
+}
+
+// WithDebugLogger logs request and response bodies at DEBUG level, passwords are redacted from requests
+func WithDebugLogger(logger Logger) Option {
+	return func(c *Client) {
+		c.debugLogger = logger
+	}
+}
+
+// WithDebugFiles writes request and response bodies to files in dir, overriding DHL24Config.DebugFiles
+// An empty dir uses the executable directory
+func WithDebugFiles(dir string) Option {
+	return func(c *Client) {
+		c.debugFiles = true
+		c.debugFilesDir = dir
+	}
+}
+
+// RequestHook receives the request and response bodies of a SOAP round-trip, passwords are redacted from requests
+type RequestHook func(operation string, requestBody, responseBody []byte)
+
+// WithRequestHook calls hook synchronously after each round-trip that returns a response, independent of debug files
+// The hook must not modify or retain the byte slices
+func WithRequestHook(hook RequestHook) Option {
+	return func(c *Client) {
+		c.requestHook = hook
+	}
+}
+
+// ResultHook receives the outcome of each SOAP request sent to the API, e.g. to record metrics
+// meta is always set, StatusCode is 0 when no response was received; err is a *SOAPFault for fault responses
+type ResultHook func(operation string, meta *ResponseMeta, err error)
+
+// WithResultHook calls hook synchronously after each request attempt, repeated options add hooks
+func WithResultHook(hook ResultHook) Option {
+	return func(c *Client) {
+		c.resultHooks = append(c.resultHooks, hook)
+	}
+}
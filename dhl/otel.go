@@ -0,0 +1,61 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation name of spans created by the client
+const tracerName = "dhl-test/dhl"
+
+// WithTracerProvider creates a span named dhl.soap.<operation> for each API call
+// The trace context is sent to the API in the traceparent header
+func WithTracerProvider(tp trace.TracerProvider) Option {
+	return func(c *Client) {
+		if tp != nil {
+			c.tracer = tp.Tracer(tracerName)
+		}
+	}
+}
+
+// traceRequest runs do inside a client span when a tracer provider is configured
+func (c *Client) traceRequest(ctx context.Context, operationName string, do func(context.Context) ([]byte, *ResponseMeta, error)) ([]byte, *ResponseMeta, error) {
+	if c.tracer == nil {
+		return do(ctx)
+	}
+
+	ctx, span := c.tracer.Start(ctx, "dhl.soap."+operationName,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(attribute.String("dhl.operation", operationName)),
+	)
+	defer span.End()
+
+	body, meta, err := do(ctx)
+	if meta != nil {
+		span.SetAttributes(attribute.Int("http.status_code", meta.StatusCode))
+	}
+	if err != nil {
+		var fault *SOAPFault
+		if errors.As(err, &fault) {
+			span.SetAttributes(attribute.Int("dhl.fault_code", fault.FaultCode()))
+		}
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	return body, meta, err
+}
+
+// injectTraceContext adds the traceparent header of the span in ctx to the outgoing request headers
+func (c *Client) injectTraceContext(ctx context.Context, header http.Header) {
+	if c.tracer == nil {
+		return
+	}
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(header))
+}
@@ -0,0 +1,111 @@
+package dhl
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// recordedAttributes returns the attributes of a finished span keyed by name
+func recordedAttributes(span sdktrace.ReadOnlySpan) map[attribute.Key]attribute.Value {
+	attrs := make(map[attribute.Key]attribute.Value)
+	for _, kv := range span.Attributes() {
+		attrs[kv.Key] = kv.Value
+	}
+	return attrs
+}
+
+func TestTracerProviderPropagatesTraceparent(t *testing.T) {
+	const response = `<Envelope><Body><getVersionResponse><getVersionResult>2.5.0</getVersionResult></getVersionResponse></Body></Envelope>`
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		io.WriteString(w, response)
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	traceID, _ := trace.TraceIDFromHex("4bf92f3577b34da6a3ce929d0e0e4736")
+	spanID, _ := trace.SpanIDFromHex("00f067aa0ba902b7")
+	ctx := trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    traceID,
+		SpanID:     spanID,
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL), WithTracerProvider(tp))
+	if _, _, err := client.GetVersion(ctx); err != nil {
+		t.Fatalf("get version: %v", err)
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "dhl.soap.getVersion" || span.SpanKind() != trace.SpanKindClient {
+		t.Errorf("span = %s (%s), want dhl.soap.getVersion (client)", span.Name(), span.SpanKind())
+	}
+	if span.Parent().SpanID() != spanID {
+		t.Errorf("span parent = %s, want %s", span.Parent().SpanID(), spanID)
+	}
+
+	attrs := recordedAttributes(span)
+	if got := attrs["dhl.operation"].AsString(); got != "getVersion" {
+		t.Errorf("dhl.operation = %q", got)
+	}
+	if got := attrs["http.status_code"].AsInt64(); got != http.StatusOK {
+		t.Errorf("http.status_code = %d", got)
+	}
+	if _, ok := attrs["dhl.fault_code"]; ok {
+		t.Error("dhl.fault_code set on a successful call")
+	}
+
+	if want := "00-" + traceID.String() + "-" + span.SpanContext().SpanID().String() + "-01"; traceparent != want {
+		t.Errorf("traceparent = %q, want %q", traceparent, want)
+	}
+}
+
+func TestTracerProviderRecordsFault(t *testing.T) {
+	const fault = `<Envelope><Body><Fault><faultcode>100</faultcode><faultstring>Invalid credentials</faultstring></Fault></Body></Envelope>`
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+		io.WriteString(w, fault)
+	}))
+	defer server.Close()
+
+	recorder := tracetest.NewSpanRecorder()
+	tp := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL), WithTracerProvider(tp))
+	if _, _, err := client.GetVersion(context.Background()); err == nil {
+		t.Fatal("expected fault error")
+	}
+
+	spans := recorder.Ended()
+	if len(spans) != 1 {
+		t.Fatalf("recorded %d spans, want 1", len(spans))
+	}
+	span := spans[0]
+	if span.Name() != "dhl.soap.getVersion" || span.Status().Code != codes.Error {
+		t.Errorf("span = %s status %v, want dhl.soap.getVersion with error status", span.Name(), span.Status())
+	}
+
+	attrs := recordedAttributes(span)
+	if got := attrs["http.status_code"].AsInt64(); got != http.StatusInternalServerError {
+		t.Errorf("http.status_code = %d", got)
+	}
+	if got := attrs["dhl.fault_code"].AsInt64(); got != faultCodeInvalidCredentials {
+		t.Errorf("dhl.fault_code = %d, want %d", got, faultCodeInvalidCredentials)
+	}
+}
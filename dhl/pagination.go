@@ -0,0 +1,215 @@
+package dhl
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// ShipmentPage represents a single page of getMyShipments results
+type ShipmentPage struct {
+	Items []ShipmentBasicData
+	// Offset is the offset of the first item on this page
+	Offset int
+}
+
+// NextOffset returns the offset of the next page, or 0 if this is the last page
+func (p ShipmentPage) NextOffset() int {
+	if len(p.Items) < myShipmentsPageSize {
+		return 0
+	}
+	return p.Offset + len(p.Items)
+}
+
+// HasMore reports whether another page is available
+func (p ShipmentPage) HasMore() bool {
+	return p.NextOffset() > 0
+}
+
+// NextToken returns the token to pass to GetMyShipmentsPage for the next page
+// The token is the offset of the next page as a decimal string, empty on the last page
+func (p ShipmentPage) NextToken() string {
+	if offset := p.NextOffset(); offset > 0 {
+		return strconv.Itoa(offset)
+	}
+	return ""
+}
+
+// GetMyShipmentsPage retrieves a single page of shipments
+// An empty token requests the first page, other tokens are offsets returned by ShipmentPage.NextToken
+// The page has no total, use GetMyShipmentsPageWithTotal when one is needed
+func (c *Client) GetMyShipmentsPage(ctx context.Context, dr DateRange, token string) (ShipmentPage, error) {
+	request := GetMyShipmentsRequest{
+		AuthData:    c.authData(),
+		CreatedFrom: dr.fromString(),
+		CreatedTo:   dr.toString(),
+	}
+
+	if token != "" {
+		offset, err := strconv.Atoi(token)
+		if err != nil {
+			return ShipmentPage{}, fmt.Errorf("invalid page token %q: %w", token, err)
+		}
+		request.Offset = offset
+	}
+
+	result, _, err := c.getMyShipments(ctx, request)
+	if err != nil {
+		return ShipmentPage{}, err
+	}
+
+	page := ShipmentPage{
+		Items:  result.Items,
+		Offset: request.Offset,
+	}
+
+	return page, nil
+}
+
+// ShipmentsPage is a page of shipments at an offset together with the total number of shipments in the range
+type ShipmentsPage struct {
+	Items  []ShipmentBasicData
+	Total  int
+	Offset int
+}
+
+// GetMyShipmentsPageWithTotal retrieves the page of shipments starting at offset and the total count,
+// e.g. to display "showing 1-100 of 347"
+// getMyShipments does not return a total, so it is requested with getMyShipmentsCount
+func (c *Client) GetMyShipmentsPageWithTotal(ctx context.Context, createdFrom, createdTo string, offset int) (*ShipmentsPage, *ResponseMeta, error) {
+	from, err := time.Parse("2006-01-02", createdFrom)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing createdFrom: %w", err)
+	}
+	to, err := time.Parse("2006-01-02", createdTo)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing createdTo: %w", err)
+	}
+
+	items, resp, err := c.GetMyShipments(ctx, createdFrom, createdTo, offset)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	total, err := c.getMyShipmentsCount(ctx, DateRange{From: from, To: to})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &ShipmentsPage{Items: items, Total: total, Offset: offset}, resp, nil
+}
+
+// getMyShipmentsCount returns the number of shipments created in the date range
+// Documentation: https://dhl24.com.pl/en/webapi2/doc/service/getMyShipmentsCount.html
+func (c *Client) getMyShipmentsCount(ctx context.Context, dr DateRange) (int, error) {
+	request := GetMyShipmentsCountRequest{
+		AuthData:    c.authData(),
+		CreatedFrom: dr.fromString(),
+		CreatedTo:   dr.toString(),
+	}
+
+	reqBody, err := c.marshalSOAPRequest("getMyShipmentsCount", request)
+	if err != nil {
+		return 0, err
+	}
+
+	body, _, err := c.doRequest(ctx, reqBody, c.namespace()+"#getMyShipmentsCount", "getMyShipmentsCount")
+	if err != nil {
+		return 0, err
+	}
+
+	var envelope SOAPResponseEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return 0, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	if envelope.Body.GetMyShipmentsCountResponse == nil {
+		return 0, fmt.Errorf("empty getMyShipmentsCount response")
+	}
+
+	return envelope.Body.GetMyShipmentsCountResponse.Count, nil
+}
+
+// GetAllShipments retrieves all shipments in the date range, following pages until the last one
+func (c *Client) GetAllShipments(ctx context.Context, dr DateRange) ([]ShipmentBasicData, error) {
+	return c.GetAllShipmentsWithProgress(ctx, dr, nil)
+}
+
+// GetShipmentIDs returns the IDs of all shipments in the date range, e.g. for status polling
+// getMyShipments has no field projection, so full records are fetched and only IDs are kept
+func (c *Client) GetShipmentIDs(ctx context.Context, dr DateRange) ([]ShipmentID, error) {
+	shipments, err := c.GetAllShipments(ctx, dr)
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]ShipmentID, len(shipments))
+	for i, shipment := range shipments {
+		ids[i] = ShipmentID(shipment.ShipmentID)
+	}
+	return ids, nil
+}
+
+// GetShipmentIDsSince returns the IDs of shipments created from since until now, for incremental sync
+// getMyShipments filters by date only, so shipments created earlier on the since day are included too
+func (c *Client) GetShipmentIDsSince(ctx context.Context, since time.Time) ([]ShipmentID, error) {
+	return c.GetShipmentIDs(ctx, DateRange{From: since, To: time.Now()})
+}
+
+// GetAllMyShipments retrieves all shipments created between createdFrom and createdTo (YYYY-MM-DD)
+// Pages are requested until one returns fewer items than the page size
+func (c *Client) GetAllMyShipments(ctx context.Context, createdFrom, createdTo string) ([]ShipmentBasicData, error) {
+	from, err := time.Parse("2006-01-02", createdFrom)
+	if err != nil {
+		return nil, fmt.Errorf("invalid createdFrom %q: %w", createdFrom, err)
+	}
+	to, err := time.Parse("2006-01-02", createdTo)
+	if err != nil {
+		return nil, fmt.Errorf("invalid createdTo %q: %w", createdTo, err)
+	}
+	return c.GetAllShipments(ctx, DateRange{From: from, To: to})
+}
+
+// GetAllShipmentsWithProgress retrieves all shipments in the date range and reports progress after each page
+// The total is requested with getMyShipmentsCount only when progress is set; the context is checked
+// between pages so a progress handler can stop a slow download by cancelling it
+func (c *Client) GetAllShipmentsWithProgress(ctx context.Context, dr DateRange, progress func(fetched, total int)) ([]ShipmentBasicData, error) {
+	total := 0
+	if progress != nil {
+		var err error
+		if total, err = c.getMyShipmentsCount(ctx, dr); err != nil {
+			return nil, err
+		}
+	}
+
+	var all []ShipmentBasicData
+	token := ""
+	for {
+		page, err := c.GetMyShipmentsPage(ctx, dr, token)
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, page.Items...)
+		if progress != nil {
+			progress(len(all), total)
+		}
+
+		if !page.HasMore() {
+			return all, nil
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		token = page.NextToken()
+	}
+}
+
+// ProgressLogger returns a progress handler that logs "fetched N/M shipments" at INFO level
+func ProgressLogger(logger Logger) func(fetched, total int) {
+	return func(fetched, total int) {
+		logger.Info(fmt.Sprintf("fetched %d/%d shipments", fetched, total), "fetched", fetched, "total", total)
+	}
+}
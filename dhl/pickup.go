@@ -0,0 +1,203 @@
+package dhl
+
+import (
+	"context"
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// pickupLookahead is the number of days searched for the next available pickup date
+const pickupLookahead = 7
+
+// PickupAvailability describes whether a courier pickup is possible on a given date
+type PickupAvailability struct {
+	Date         time.Time
+	Available    bool
+	EarliestTime string
+	LatestTime   string
+	// NextAvailableDate is set when the pickup is not available on Date
+	NextAvailableDate *time.Time
+}
+
+// IsToday reports whether the availability date is today
+func (p PickupAvailability) IsToday() bool {
+	y1, m1, d1 := p.Date.Date()
+	y2, m2, d2 := time.Now().In(p.Date.Location()).Date()
+	return y1 == y2 && m1 == m2 && d1 == d2
+}
+
+// GetPickupAvailability checks whether a courier pickup is available for a postal code on the given date
+// If not, the following days are checked to fill NextAvailableDate
+func (c *Client) GetPickupAvailability(ctx context.Context, postalCode string, date time.Time) (*PickupAvailability, error) {
+	services, _, err := c.getPostalCodeServices(ctx, postalCode, date)
+	if err != nil {
+		return nil, err
+	}
+
+	availability := &PickupAvailability{
+		Date:         date,
+		Available:    pickupTimeSet(services.DrPickupFrom) && pickupTimeSet(services.DrPickupTo),
+		EarliestTime: services.DrPickupFrom,
+		LatestTime:   services.DrPickupTo,
+	}
+	if availability.Available {
+		return availability, nil
+	}
+
+	for i := 1; i <= pickupLookahead; i++ {
+		next := date.AddDate(0, 0, i)
+		services, _, err := c.getPostalCodeServices(ctx, postalCode, next)
+		if err != nil {
+			return nil, err
+		}
+		if pickupTimeSet(services.DrPickupFrom) && pickupTimeSet(services.DrPickupTo) {
+			availability.NextAvailableDate = &next
+			break
+		}
+	}
+
+	return availability, nil
+}
+
+// pickupTimeSet reports whether a pickup hour returned by DHL24 is set ("brak" means none)
+func pickupTimeSet(t string) bool {
+	t = strings.TrimSpace(t)
+	return t != "" && !strings.EqualFold(t, "brak")
+}
+
+// GetPostalCodeServices returns the product codes available for a Polish postal code for pickups today
+// Only postal-code dependent products are reported: "09" (domestic 09), "12" (domestic 12) and "DW" (evening delivery),
+// the standard domestic product AH is available for every served postal code and is not listed
+// Documentation: https://dhl24.com.pl/en/webapi2/doc/service/getPostalCodeServices.html
+func (c *Client) GetPostalCodeServices(ctx context.Context, postalCode string) ([]string, *ResponseMeta, error) {
+	services, resp, err := c.getPostalCodeServices(ctx, postalCode, time.Now())
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var products []string
+	if services.DomesticExpress9 {
+		products = append(products, "09")
+	}
+	if services.DomesticExpress12 {
+		products = append(products, "12")
+	}
+	if services.DeliveryEvening {
+		products = append(products, "DW")
+	}
+
+	return products, resp, nil
+}
+
+// getPostalCodeServices retrieves services and pickup hours for a postal code and pickup date
+// Documentation: https://dhl24.com.pl/en/webapi2/doc/service/getPostalCodeServices.html
+func (c *Client) getPostalCodeServices(ctx context.Context, postalCode string, pickupDate time.Time) (*PostalCodeServices, *ResponseMeta, error) {
+	request := GetPostalCodeServicesRequest{
+		AuthData:   c.authData(),
+		PostCode:   strings.ReplaceAll(postalCode, "-", ""),
+		PickupDate: pickupDate.Format("2006-01-02"),
+	}
+
+	reqBody, err := c.marshalSOAPRequest("getPostalCodeServices", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#getPostalCodeServices", "getPostalCodeServices")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var envelope SOAPResponseEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, resp, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	if envelope.Body.GetPostalCodeServicesResponse == nil {
+		return nil, resp, fmt.Errorf("empty getPostalCodeServices response")
+	}
+
+	return &envelope.Body.GetPostalCodeServicesResponse.Result, resp, nil
+}
+
+// PickupWindow is a time slot in which a courier pickup can be scheduled
+type PickupWindow struct {
+	// WindowID identifies the window in a PickupRequest, it has the form "YYYY-MM-DD/HH:MM-HH:MM"
+	WindowID string
+	From     string // HH:MM
+	To       string // HH:MM
+}
+
+// PickupRequest schedules a courier pickup in a window returned by GetPickupWindows
+type PickupRequest struct {
+	WindowID         string
+	ShipmentIDs      []ShipmentID
+	AdditionalInfo   string
+	CourierWithLabel bool
+}
+
+// PickupConfirmation describes a scheduled courier pickup
+type PickupConfirmation struct {
+	// OrderIDs are the pickup confirmation numbers returned by bookCourier
+	OrderIDs []string
+	Date     string
+	From     string
+	To       string
+}
+
+// GetPickupWindows returns the pickup time slots available for a postal code on date (YYYY-MM-DD)
+// DHL24 has no getPickupWindow operation, so the window is the pickup hours reported by getPostalCodeServices,
+// an empty slice means no pickup is possible on that date
+func (c *Client) GetPickupWindows(ctx context.Context, postalCode, date string) ([]PickupWindow, *ResponseMeta, error) {
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error parsing pickup date: %w", err)
+	}
+
+	services, resp, err := c.getPostalCodeServices(ctx, postalCode, day)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if !pickupTimeSet(services.DrPickupFrom) || !pickupTimeSet(services.DrPickupTo) {
+		return []PickupWindow{}, resp, nil
+	}
+
+	from := strings.TrimSpace(services.DrPickupFrom)
+	to := strings.TrimSpace(services.DrPickupTo)
+	return []PickupWindow{{
+		WindowID: date + "/" + from + "-" + to,
+		From:     from,
+		To:       to,
+	}}, resp, nil
+}
+
+// SchedulePickup books a courier for the shipments in the requested window using bookCourier
+func (c *Client) SchedulePickup(ctx context.Context, req PickupRequest) (*PickupConfirmation, *ResponseMeta, error) {
+	date, hours, ok := strings.Cut(req.WindowID, "/")
+	from, to, ok2 := strings.Cut(hours, "-")
+	if !ok || !ok2 {
+		return nil, nil, fmt.Errorf("invalid pickup window ID %q", req.WindowID)
+	}
+
+	day, err := time.Parse("2006-01-02", date)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid pickup window ID %q: %w", req.WindowID, err)
+	}
+
+	orderIDs, resp, err := c.BookCourier(ctx, CourierBooking{
+		PickupDate:       day,
+		PickupTimeFrom:   from,
+		PickupTimeTo:     to,
+		ShipmentIDs:      req.ShipmentIDs,
+		AdditionalInfo:   req.AdditionalInfo,
+		CourierWithLabel: req.CourierWithLabel,
+	})
+	if err != nil {
+		return nil, resp, err
+	}
+
+	return &PickupConfirmation{OrderIDs: orderIDs, Date: date, From: from, To: to}, resp, nil
+}
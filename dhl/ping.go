@@ -0,0 +1,20 @@
+package dhl
+
+import (
+	"context"
+	"time"
+)
+
+// Ping checks that the API is reachable and returns its version
+// getVersion needs no credentials, so Ping does not verify authentication
+func (c *Client) Ping(ctx context.Context) (string, error) {
+	version, _, err := c.GetVersion(ctx)
+	return version, err
+}
+
+// CheckAuth verifies the configured credentials with a lightweight authenticated call
+func (c *Client) CheckAuth(ctx context.Context) error {
+	today := time.Now()
+	_, err := c.getMyShipmentsCount(ctx, DateRange{From: today, To: today})
+	return err
+}
@@ -0,0 +1,162 @@
+package dhl
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+)
+
+// PostalCodeInfo describes the place a postal code belongs to
+type PostalCodeInfo struct {
+	PostalCode PostalCode
+	Cities     []string
+	// State is the voivodeship, e.g. "mazowieckie"
+	State string
+}
+
+// PostalCodeLookup resolves a postal code to the cities it covers
+type PostalCodeLookup interface {
+	LookupPostalCode(ctx context.Context, postalCode PostalCode) (*PostalCodeInfo, error)
+}
+
+// ErrAmbiguousPostalCode is returned when a postal code covers more than one city
+type ErrAmbiguousPostalCode struct {
+	PostalCode PostalCode
+	Cities     []string
+}
+
+// Error implements the error interface
+func (e ErrAmbiguousPostalCode) Error() string {
+	return fmt.Sprintf("postal code %s covers multiple cities: %s", e.PostalCode, strings.Join(e.Cities, ", "))
+}
+
+// ErrNoPostalCodeLookup is returned by postal code lookups when the client has no PostalCodeLookup
+var ErrNoPostalCodeLookup = errors.New("no postal code lookup configured, use WithPostalCodeLookup")
+
+// WithPostalCodeLookup sets the service used by GetPostalCodeInfo, e.g. NewNominatimGeocoder(userAgent)
+// No lookup is configured by default, so addresses are only sent to a third-party service when opted in
+func WithPostalCodeLookup(lookup PostalCodeLookup) Option {
+	return func(c *Client) {
+		c.postalCodeLookup = lookup
+	}
+}
+
+// GetPostalCodeInfo returns the cities and voivodeship of a Polish postal code
+// DHL24 has no postal code lookup operation, so the configured PostalCodeLookup is used
+// ErrAddressNotFound is returned when the lookup knows no city for the postal code
+func (c *Client) GetPostalCodeInfo(ctx context.Context, postalCode PostalCode) (*PostalCodeInfo, error) {
+	if !polishPostalCodePattern.MatchString(string(postalCode)) {
+		return nil, fmt.Errorf("invalid Polish postal code %q", postalCode)
+	}
+	if c.postalCodeLookup == nil {
+		return nil, ErrNoPostalCodeLookup
+	}
+
+	info, err := c.postalCodeLookup.LookupPostalCode(ctx, postalCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(info.Cities) == 0 {
+		return nil, ErrAddressNotFound
+	}
+	return info, nil
+}
+
+// GetCityForPostalCode returns the single city of a postal code
+// ErrAmbiguousPostalCode is returned when the postal code covers more than one city
+func (c *Client) GetCityForPostalCode(ctx context.Context, postalCode PostalCode) (string, error) {
+	info, err := c.GetPostalCodeInfo(ctx, postalCode)
+	if err != nil {
+		return "", err
+	}
+	if len(info.Cities) > 1 {
+		return "", ErrAmbiguousPostalCode{PostalCode: postalCode, Cities: info.Cities}
+	}
+	return info.Cities[0], nil
+}
+
+// FillFromPostalCode sets PostalCode, City and State from the postal code, other fields are left unchanged
+// ErrAmbiguousPostalCode is returned, and the address is not modified, when the postal code covers more than one city
+func (a *Address) FillFromPostalCode(ctx context.Context, postalCode PostalCode, client *Client) error {
+	info, err := client.GetPostalCodeInfo(ctx, postalCode)
+	if err != nil {
+		return err
+	}
+	if len(info.Cities) > 1 {
+		return ErrAmbiguousPostalCode{PostalCode: postalCode, Cities: info.Cities}
+	}
+
+	a.PostalCode = string(postalCode)
+	a.City = info.Cities[0]
+	a.State = info.State
+	return nil
+}
+
+// LookupPostalCode implements PostalCodeLookup using the Nominatim search API
+func (g *NominatimGeocoder) LookupPostalCode(ctx context.Context, postalCode PostalCode) (*PostalCodeInfo, error) {
+	if err := g.wait(ctx); err != nil {
+		return nil, err
+	}
+
+	query := url.Values{}
+	query.Set("format", "json")
+	query.Set("addressdetails", "1")
+	query.Set("limit", "10")
+	query.Set("countrycodes", "pl")
+	query.Set("postalcode", string(postalCode))
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, NominatimEndpoint+"?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("error creating request: %w", err)
+	}
+	req.Header.Set("User-Agent", g.UserAgent)
+
+	resp, err := g.HTTPClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error making request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("nominatim returned status %s", resp.Status)
+	}
+
+	var results []struct {
+		Address struct {
+			City    string `json:"city"`
+			Town    string `json:"town"`
+			Village string `json:"village"`
+			State   string `json:"state"`
+		} `json:"address"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&results); err != nil {
+		return nil, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	info := &PostalCodeInfo{PostalCode: postalCode}
+	for _, result := range results {
+		city := result.Address.City
+		if city == "" {
+			city = result.Address.Town
+		}
+		if city == "" {
+			city = result.Address.Village
+		}
+		if city != "" && !slices.Contains(info.Cities, city) {
+			info.Cities = append(info.Cities, city)
+		}
+		if info.State == "" {
+			info.State = strings.TrimPrefix(result.Address.State, "województwo ")
+		}
+	}
+	if len(info.Cities) == 0 {
+		return nil, ErrAddressNotFound
+	}
+
+	return info, nil
+}
@@ -0,0 +1,35 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"testing"
+)
+
+// stubPostalCodeLookup returns a fixed result for every postal code
+type stubPostalCodeLookup struct {
+	info *PostalCodeInfo
+}
+
+func (l stubPostalCodeLookup) LookupPostalCode(ctx context.Context, postalCode PostalCode) (*PostalCodeInfo, error) {
+	return l.info, nil
+}
+
+func TestGetCityForPostalCodeWithoutLookup(t *testing.T) {
+	client := NewClient(&DHL24Config{})
+	if _, err := client.GetCityForPostalCode(context.Background(), "00-001"); !errors.Is(err, ErrNoPostalCodeLookup) {
+		t.Errorf("GetCityForPostalCode() error = %v, want ErrNoPostalCodeLookup", err)
+	}
+}
+
+func TestGetCityForPostalCodeWithoutCities(t *testing.T) {
+	client := NewClient(&DHL24Config{}, WithPostalCodeLookup(stubPostalCodeLookup{info: &PostalCodeInfo{PostalCode: "00-001"}}))
+	if _, err := client.GetCityForPostalCode(context.Background(), "00-001"); !errors.Is(err, ErrAddressNotFound) {
+		t.Errorf("GetCityForPostalCode() error = %v, want ErrAddressNotFound", err)
+	}
+
+	var address Address
+	if err := address.FillFromPostalCode(context.Background(), "00-001", client); !errors.Is(err, ErrAddressNotFound) {
+		t.Errorf("FillFromPostalCode() error = %v, want ErrAddressNotFound", err)
+	}
+}
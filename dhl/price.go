@@ -0,0 +1,172 @@
+package dhl
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+)
+
+// priceCurrency is the currency of DHL24 price quotes
+const priceCurrency = "PLN"
+
+// Price line item types
+const (
+	PriceLineBase = "BASE"
+	PriceLineFuel = "FUEL_SURCHARGE"
+)
+
+// PriceLineItem is a single charge component of a shipment price
+type PriceLineItem struct {
+	Description string
+	Amount      float64
+	Currency    string
+	Type        string
+}
+
+// PriceBreakdown lists the charge components of a shipment price
+type PriceBreakdown struct {
+	ShipmentID ShipmentID
+	Items      []PriceLineItem
+	Total      float64
+}
+
+// GetPrice returns the net price quote for a shipment
+// Documentation: https://dhl24.com.pl/en/webapi2/doc/service/getPrice.html
+func (c *Client) GetPrice(ctx context.Context, req ShipmentRequest) (*PriceResult, *ResponseMeta, error) {
+	request := GetPriceRequest{
+		AuthData: c.authData(),
+		Shipment: req,
+	}
+
+	reqBody, err := c.marshalSOAPRequest("getPrice", request)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, resp, err := c.doRequest(ctx, reqBody, c.namespace()+"#getPrice", "getPrice")
+	if err != nil {
+		return nil, resp, err
+	}
+
+	var envelope SOAPResponseEnvelope
+	if err := xml.Unmarshal(body, &envelope); err != nil {
+		return nil, resp, fmt.Errorf("error parsing response: %w", err)
+	}
+
+	if envelope.Body.GetPriceResponse == nil {
+		return nil, resp, fmt.Errorf("empty getPrice response")
+	}
+
+	return &envelope.Body.GetPriceResponse.Result, resp, nil
+}
+
+// PriceInfoRequest describes a route and parcels to list available products and prices for
+// Dimensions are in cm and weights in kg
+type PriceInfoRequest struct {
+	ShipperPostalCode  string
+	ReceiverPostalCode string
+	// ReceiverCountry defaults to the client default country
+	ReceiverCountry CountryCode
+	Pieces          []Piece
+	ServiceDate     time.Time
+}
+
+// PriceItem is a product available for a route with its price
+type PriceItem struct {
+	ProductCode ProductCode
+	ProductName string
+	// Price is the net price including the fuel charge, getPrice quotes exclude VAT
+	Price    float64
+	Currency string
+}
+
+// GetPriceInfo lists the products available for a route with their prices
+// DHL24 has no operation listing prices, so every documented product is quoted with getPrice;
+// products rejected with a SOAP fault, e.g. not available for the account or route, are left out;
+// the returned ResponseMeta is the one of the last getPrice call
+func (c *Client) GetPriceInfo(ctx context.Context, req PriceInfoRequest) ([]PriceItem, *ResponseMeta, error) {
+	country := req.ReceiverCountry
+	if country == "" {
+		country = c.defaultCountry
+	}
+
+	shipment := ShipmentItem{
+		Shipper:      Address{PostalCode: req.ShipperPostalCode},
+		Receiver:     Address{PostalCode: req.ReceiverPostalCode, Country: string(country)},
+		PieceList:    PieceList{Items: req.Pieces},
+		Payment:      Payment{PayerType: PaymentTypeShipper, PaymentMethod: "BANK_TRANSFER"},
+		ShipmentDate: req.ServiceDate.Format("2006-01-02"),
+	}
+	shipment.ApplyConfigDefaults(c.config)
+
+	var items []PriceItem
+	var resp *ResponseMeta
+	var lastFault error
+	for _, product := range validProductCodes {
+		shipment.Service.Product = product
+		price, meta, err := c.GetPrice(ctx, shipment)
+		resp = meta
+		if isProductFault(err) {
+			lastFault = err
+			continue
+		}
+		if err != nil {
+			return nil, resp, err
+		}
+
+		item := PriceItem{
+			ProductCode: product,
+			ProductName: productNames[product],
+			Price:       math.Round((price.Price+price.FuelCharge)*100) / 100,
+			Currency:    priceCurrency,
+		}
+
+		items = append(items, item)
+	}
+
+	if len(items) == 0 && lastFault != nil {
+		return nil, resp, lastFault
+	}
+	return items, resp, nil
+}
+
+// isProductFault reports whether err is a SOAP fault rejecting a single product, rather than the request as a whole
+func isProductFault(err error) bool {
+	var fault *SOAPFault
+	return errors.As(err, &fault) && !errors.Is(err, ErrSessionExpired) && fault.FaultCode() != faultCodeInvalidCredentials
+}
+
+// GetShipmentPriceBreakdown returns the charge components of a created shipment for invoice reconciliation
+// DHL24 has no post-creation price endpoint, so the breakdown is based on the quote API:
+// getPrice is called with the original shipment request, prices may differ if the tariff changed since creation
+func (c *Client) GetShipmentPriceBreakdown(ctx context.Context, shipmentID ShipmentID, original ShipmentRequest) (*PriceBreakdown, error) {
+	price, _, err := c.GetPrice(ctx, original)
+	if err != nil {
+		return nil, err
+	}
+
+	breakdown := &PriceBreakdown{
+		ShipmentID: shipmentID,
+		Items: []PriceLineItem{
+			{Description: "Base rate", Amount: price.Price, Currency: priceCurrency, Type: PriceLineBase},
+		},
+	}
+	if price.FuelCharge != 0 {
+		breakdown.Items = append(breakdown.Items, PriceLineItem{
+			Description: fmt.Sprintf("Fuel surcharge %.2f%%", price.FuelSurcharge),
+			Amount:      price.FuelCharge,
+			Currency:    priceCurrency,
+			Type:        PriceLineFuel,
+		})
+	}
+
+	for _, item := range breakdown.Items {
+		breakdown.Total += item.Amount
+	}
+	breakdown.Total = math.Round(breakdown.Total*100) / 100
+
+	return breakdown, nil
+}
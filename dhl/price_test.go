@@ -0,0 +1,54 @@
+package dhl
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestGetPriceInfoQuotesEachProduct(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		request := string(body)
+		switch {
+		case strings.Contains(request, "<product>AH</product>"):
+			io.WriteString(w, `<Envelope><Body><getPriceResponse><getPriceResult><price>20.5</price><fuelSurcharge>10</fuelSurcharge><fuelCharge>2.05</fuelCharge></getPriceResult></getPriceResponse></Body></Envelope>`)
+		default:
+			io.WriteString(w, `<Envelope><Body><Fault><faultcode>131</faultcode><faultstring>Product retrieval error</faultstring></Fault></Body></Envelope>`)
+		}
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL))
+
+	items, _, err := client.GetPriceInfo(context.Background(), PriceInfoRequest{
+		ShipperPostalCode:  "01249",
+		ReceiverPostalCode: "30001",
+		Pieces:             []Piece{{Type: "PACKAGE", Quantity: 1, Weight: 2, Width: 20, Height: 10, Length: 30}},
+		ServiceDate:        time.Date(2026, 10, 19, 0, 0, 0, 0, time.UTC),
+	})
+	if err != nil {
+		t.Fatalf("GetPriceInfo() error = %v", err)
+	}
+	want := PriceItem{ProductCode: ProductParcel, ProductName: "DHL Parcel", Price: 22.55, Currency: "PLN"}
+	if len(items) != 1 || items[0] != want {
+		t.Errorf("GetPriceInfo() = %+v, want [%+v]", items, want)
+	}
+}
+
+func TestGetPriceInfoReturnsFaultWhenNoProductIsAvailable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		io.WriteString(w, `<Envelope><Body><Fault><faultcode>131</faultcode><faultstring>Product retrieval error</faultstring></Fault></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL))
+
+	if items, _, err := client.GetPriceInfo(context.Background(), PriceInfoRequest{ServiceDate: time.Now()}); err == nil {
+		t.Errorf("GetPriceInfo() = %+v, want fault", items)
+	}
+}
@@ -0,0 +1,58 @@
+package dhl
+
+import "slices"
+
+// ProductCode is a DHL24 product code, it is the same type as ServiceCode
+type ProductCode = ServiceCode
+
+// DHL24 product codes
+const (
+	ProductParcel            ProductCode = "AH" // domestic parcel
+	ProductExpress9          ProductCode = "09" // domestic delivery by 9:00
+	ProductExpress12         ProductCode = "12" // domestic delivery by 12:00
+	ProductEvening           ProductCode = "DW" // domestic evening delivery
+	ProductServicePoint      ProductCode = "SP" // delivery to a DHL service point
+	ProductConnect           ProductCode = "EK" // DHL Parcel Connect
+	ProductConnectPlus       ProductCode = "CP" // DHL Parcel Connect Plus
+	ProductConnectPlusPallet ProductCode = "CM" // DHL Parcel Connect Plus pallet
+	ProductInternational     ProductCode = "PI" // DHL Parcel International
+	ProductPremium           ProductCode = "PR" // DHL Premium
+)
+
+// validProductCodes lists all documented product codes
+var validProductCodes = []ProductCode{
+	ProductParcel,
+	ProductExpress9,
+	ProductExpress12,
+	ProductEvening,
+	ProductServicePoint,
+	ProductConnect,
+	ProductConnectPlus,
+	ProductConnectPlusPallet,
+	ProductInternational,
+	ProductPremium,
+}
+
+// productNames are human readable product names
+var productNames = map[ProductCode]string{
+	ProductParcel:            "DHL Parcel",
+	ProductExpress9:          "DHL Parcel 9",
+	ProductExpress12:         "DHL Parcel 12",
+	ProductEvening:           "DHL Parcel Evening",
+	ProductServicePoint:      "DHL Parcel ServicePoint",
+	ProductConnect:           "DHL Parcel Connect",
+	ProductConnectPlus:       "DHL Parcel Connect Plus",
+	ProductConnectPlusPallet: "DHL Parcel Connect Plus Pallet",
+	ProductInternational:     "DHL Parcel International",
+	ProductPremium:           "DHL Premium",
+}
+
+// ValidProductCodes returns all documented DHL24 product codes
+func ValidProductCodes() []ProductCode {
+	return slices.Clone(validProductCodes)
+}
+
+// IsValid reports whether the code is a documented DHL24 product code
+func (p ProductCode) IsValid() bool {
+	return slices.Contains(validProductCodes, p)
+}
@@ -0,0 +1,82 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// retryableStatusCodes are HTTP statuses treated as transient
+var retryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+// RetryPolicy configures retries of transient failures: network errors and HTTP 429, 502, 503 and 504
+// SOAP faults are never retried by the policy
+type RetryPolicy struct {
+	// MaxAttempts is the total number of attempts, values below 2 disable retries
+	MaxAttempts int
+	// InitialDelay is the delay before the first retry
+	InitialDelay time.Duration
+	// MaxDelay caps the delay between attempts, 0 means no cap
+	MaxDelay time.Duration
+	// Multiplier grows the delay after each retry, values below 1 keep it constant
+	Multiplier float64
+}
+
+// WithRetryPolicy retries transient request failures according to the policy
+func WithRetryPolicy(p RetryPolicy) Option {
+	return func(c *Client) {
+		c.retryPolicy = p
+	}
+}
+
+// delay returns the wait before retry number attempt, starting at 0
+func (p RetryPolicy) delay(attempt int) time.Duration {
+	d := float64(p.InitialDelay)
+	for i := 0; i < attempt && p.Multiplier > 1; i++ {
+		d *= p.Multiplier
+		if p.MaxDelay > 0 && d >= float64(p.MaxDelay) {
+			return p.MaxDelay
+		}
+	}
+	return time.Duration(d)
+}
+
+// sendRequestWithRetry sends a request, retrying transient failures according to the client retry policy
+func (c *Client) sendRequestWithRetry(ctx context.Context, body []byte, soapAction string, operationName string) (*http.Response, error) {
+	for attempt := 0; ; attempt++ {
+		resp, err := c.sendRequest(ctx, body, soapAction, operationName)
+
+		transient := err != nil && ctx.Err() == nil
+		if tooLarge := (ErrRequestTooLarge{}); errors.As(err, &tooLarge) {
+			transient = false
+		}
+		if err == nil && slices.Contains(retryableStatusCodes, resp.StatusCode) {
+			transient = true
+		}
+		if !transient || attempt+1 >= c.retryPolicy.MaxAttempts {
+			return resp, err
+		}
+
+		reason := fmt.Sprint(err)
+		if resp != nil {
+			reason = resp.Status
+			resp.Body.Close()
+		}
+
+		delay := c.retryPolicy.delay(attempt)
+		c.logger.Warn("DHL24 request failed, retrying", "operation", operationName, "attempt", attempt+1, "reason", reason, "delay", delay)
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
@@ -0,0 +1,113 @@
+package dhl
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// ShipmentTemplate contains shipment fields that are not available in ShipmentBasicData
+type ShipmentTemplate struct {
+	ReceiverCountry      string
+	PieceList            PieceList
+	Payment              Payment
+	Service              Service
+	SkipRestrictionCheck bool
+	Comment              string
+	Content              string
+}
+
+// ToAddress converts response address information to a request Address
+func (a AddressInfo) ToAddress() Address {
+	return Address{
+		Name:            a.Name,
+		PostalCode:      a.PostalCode,
+		City:            a.City,
+		Street:          a.Street,
+		HouseNumber:     a.HouseNumber,
+		ApartmentNumber: a.ApartmentNumber,
+		ContactPerson:   a.ContactPerson,
+		ContactPhone:    a.ContactPhone,
+		ContactEmail:    a.ContactEmail,
+	}
+}
+
+// createdTimeLayout is the format of ShipmentBasicData.Created, e.g. "2026-10-01 12:30:00" in local time
+const createdTimeLayout = "2006-01-02 15:04:05"
+
+// CreatedAt parses the creation time of the shipment using createdTimeLayout
+func (s ShipmentBasicData) CreatedAt() (time.Time, error) {
+	t, err := time.ParseInLocation(createdTimeLayout, s.Created, time.Local)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error parsing created time %q: %w", s.Created, err)
+	}
+	return t, nil
+}
+
+// CreatedAtOrZero returns the creation time of the shipment or zero time if it cannot be parsed
+// Useful for sorting where an unparsable time can be treated as oldest
+func (s ShipmentBasicData) CreatedAtOrZero() time.Time {
+	t, _ := s.CreatedAt()
+	return t
+}
+
+// ToShipmentItem builds a new ShipmentItem from an existing shipment, e.g. to re-send a lost parcel
+// Pieces, payment and service are taken from the template since getMyShipments does not return them
+// The caller must verify that the template service product is still available for the route
+func (s ShipmentBasicData) ToShipmentItem(newDate time.Time, tmpl ShipmentTemplate) ShipmentItem {
+	receiver := s.Receiver.ToAddress()
+	receiver.Country = tmpl.ReceiverCountry
+
+	return ShipmentItem{
+		Shipper:              s.Shipper.ToAddress(),
+		Receiver:             receiver,
+		PieceList:            tmpl.PieceList,
+		Payment:              tmpl.Payment,
+		Service:              tmpl.Service,
+		ShipmentDate:         newDate.Format("2006-01-02"),
+		SkipRestrictionCheck: tmpl.SkipRestrictionCheck,
+		Comment:              tmpl.Comment,
+		Content:              tmpl.Content,
+	}
+}
+
+// ApplyConfigDefaults fills empty drop-off type, label type, payment account number and payment type from config
+// Fields already set are kept, the item is returned for chaining
+func (s *ShipmentItem) ApplyConfigDefaults(config *DHL24Config) *ShipmentItem {
+	if config.DefaultDropOffType != "" || config.DefaultLabelFormat != "" {
+		if s.ShipmentInfo == nil {
+			s.ShipmentInfo = &ShipmentInfo{}
+		}
+		if s.ShipmentInfo.DropOffType == "" {
+			s.ShipmentInfo.DropOffType = config.DefaultDropOffType
+		}
+		if s.ShipmentInfo.LabelType == "" {
+			s.ShipmentInfo.LabelType = config.DefaultLabelFormat
+		}
+	}
+	if s.Payment.AccountNumber == "" {
+		s.Payment.AccountNumber = config.AccountNumber
+	}
+	if s.Payment.PaymentType == "" {
+		s.Payment.PaymentType = config.DefaultPaymentType
+	}
+	return s
+}
+
+// shipmentIDPattern matches DHL24 shipment IDs, which are numeric
+var shipmentIDPattern = regexp.MustCompile(`^\d{1,20}$`)
+
+// ParseShipmentID validates a DHL24 shipment ID, surrounding whitespace is ignored
+func ParseShipmentID(s string) (ShipmentID, error) {
+	s = strings.TrimSpace(s)
+	if !shipmentIDPattern.MatchString(s) {
+		return "", fmt.Errorf("invalid shipment ID %q: must be numeric", s)
+	}
+	return ShipmentID(s), nil
+}
+
+// String returns the shipment ID as a string
+func (id ShipmentID) String() string {
+	return string(id)
+}
@@ -0,0 +1,36 @@
+package dhl
+
+import (
+	"context"
+	"time"
+)
+
+// statusCacheTTL is how long shipment statuses are cached to avoid hammering the API when polling
+const statusCacheTTL = 30 * time.Second
+
+// GetShipmentStatus returns the current status of a shipment
+// DHL24 has no status-only operation, so the status is taken from the latest
+// getTrackAndTraceInfo event, which is lighter than fetching full shipment details
+// Results are cached for 30 seconds
+func (c *Client) GetShipmentStatus(ctx context.Context, shipmentID ShipmentID) (OrderStatus, error) {
+	if status, ok := c.statusCache.get(shipmentID); ok {
+		return status, nil
+	}
+
+	info, _, err := c.getTrackAndTraceInfo(ctx, shipmentID)
+	if err != nil {
+		return "", err
+	}
+
+	status := OrderStatusNew
+	if events := info.Events.Items; len(events) > 0 {
+		var ok bool
+		if status, ok = eventStatuses[events[len(events)-1].Status]; !ok {
+			status = OrderStatusUnknown
+		}
+	}
+
+	c.statusCache.set(shipmentID, status)
+
+	return status, nil
+}
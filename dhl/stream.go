@@ -0,0 +1,140 @@
+package dhl
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// defaultStreamBufferSize is the default channel buffer size of StreamMyShipments
+const defaultStreamBufferSize = 100
+
+// WithStreamBufferSize sets the channel buffer size used by StreamMyShipments
+func WithStreamBufferSize(size int) Option {
+	return func(c *Client) {
+		c.streamBufferSize = size
+	}
+}
+
+// StreamMyShipments fetches pages sequentially and sends shipments to the returned channel as each page arrives
+// Both channels are closed when all pages are fetched, an error occurs or the context is cancelled
+// At most one error is sent to the error channel
+func (c *Client) StreamMyShipments(ctx context.Context, dr DateRange) (<-chan ShipmentBasicData, <-chan error) {
+	bufferSize := c.streamBufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultStreamBufferSize
+	}
+
+	items := make(chan ShipmentBasicData, bufferSize)
+	errs := make(chan error, 1)
+
+	go func() {
+		defer close(items)
+		defer close(errs)
+
+		token := ""
+		for {
+			page, err := c.GetMyShipmentsPage(ctx, dr, token)
+			if err != nil {
+				errs <- err
+				return
+			}
+
+			for _, shipment := range page.Items {
+				select {
+				case items <- shipment:
+				case <-ctx.Done():
+					errs <- ctx.Err()
+					return
+				}
+			}
+
+			if !page.HasMore() {
+				return
+			}
+			token = page.NextToken()
+		}
+	}()
+
+	return items, errs
+}
+
+// StreamMyShipmentsToWriter streams all shipments in the date range to w in "json" or "csv" format
+// JSON output is a single array written incrementally, CSV output starts with a header row
+func (c *Client) StreamMyShipmentsToWriter(ctx context.Context, dr DateRange, w io.Writer, format string) error {
+	var write func(ShipmentBasicData) error
+	var finish func() error
+
+	switch format {
+	case "json":
+		encoder := json.NewEncoder(w)
+		first := true
+		if _, err := io.WriteString(w, "["); err != nil {
+			return err
+		}
+		write = func(s ShipmentBasicData) error {
+			if !first {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			first = false
+			return encoder.Encode(s)
+		}
+		finish = func() error {
+			_, err := io.WriteString(w, "]\n")
+			return err
+		}
+	case "csv":
+		writer := csv.NewWriter(w)
+		if err := writer.Write(shipmentCSVHeader); err != nil {
+			return err
+		}
+		write = func(s ShipmentBasicData) error {
+			return writer.Write(shipmentCSVRecord(s))
+		}
+		finish = func() error {
+			writer.Flush()
+			return writer.Error()
+		}
+	default:
+		return fmt.Errorf("unsupported stream format %q (use json or csv)", format)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	items, errs := c.StreamMyShipments(ctx, dr)
+	for shipment := range items {
+		if err := write(shipment); err != nil {
+			return fmt.Errorf("error writing shipment %s: %w", shipment.ShipmentID, err)
+		}
+	}
+	if err := <-errs; err != nil {
+		return err
+	}
+
+	return finish()
+}
+
+// shipmentCSVHeader lists CSV columns for shipment export
+var shipmentCSVHeader = []string{
+	"shipmentId", "created", "orderStatus",
+	"shipperName", "shipperPostalCode", "shipperCity", "shipperStreet", "shipperHouseNumber", "shipperApartmentNumber",
+	"shipperContactPerson", "shipperContactPhone", "shipperContactEmail",
+	"receiverName", "receiverPostalCode", "receiverCity", "receiverStreet", "receiverHouseNumber", "receiverApartmentNumber",
+	"receiverContactPerson", "receiverContactPhone", "receiverContactEmail",
+}
+
+// shipmentCSVRecord returns CSV values of a shipment in shipmentCSVHeader order
+func shipmentCSVRecord(s ShipmentBasicData) []string {
+	return []string{
+		s.ShipmentID, s.Created, s.OrderStatus,
+		s.Shipper.Name, s.Shipper.PostalCode, s.Shipper.City, s.Shipper.Street, s.Shipper.HouseNumber, s.Shipper.ApartmentNumber,
+		s.Shipper.ContactPerson, s.Shipper.ContactPhone, s.Shipper.ContactEmail,
+		s.Receiver.Name, s.Receiver.PostalCode, s.Receiver.City, s.Receiver.Street, s.Receiver.HouseNumber, s.Receiver.ApartmentNumber,
+		s.Receiver.ContactPerson, s.Receiver.ContactPhone, s.Receiver.ContactEmail,
+	}
+}
@@ -0,0 +1,144 @@
+package dhl
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"text/template"
+	"time"
+)
+
+//go:embed templates/*.xml.tmpl
+var templateFS embed.FS
+
+// templateSuffix is the file name suffix of SOAP request templates
+const templateSuffix = ".xml.tmpl"
+
+// TemplateFuncMap contains helper functions available in SOAP request templates
+var TemplateFuncMap = template.FuncMap{
+	"xmlEscape":    escapeTemplateXML,
+	"formatDate":   formatTemplateDate,
+	"formatWeight": formatTemplateWeight,
+}
+
+// escapeTemplateXML escapes any value for XML text, named string types like ProductCode are accepted
+func escapeTemplateXML(v interface{}) string {
+	return escapeXML(fmt.Sprint(v))
+}
+
+// SOAPTemplateEngine renders SOAP request envelopes from named text/template templates
+// Template names are operation names, e.g. "createShipments"
+type SOAPTemplateEngine struct {
+	templates map[string]*template.Template
+	errors    map[string]error
+}
+
+// templateData is passed to SOAP request templates
+type templateData struct {
+	Namespace string
+	// Endpoint is the URL the request is sent to
+	Endpoint string
+	Request  interface{}
+}
+
+// builtinTemplates parses the embedded templates once per process
+var builtinTemplates = sync.OnceValues(func() (map[string]*template.Template, error) {
+	files, err := templateFS.ReadDir("templates")
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make(map[string]*template.Template, len(files))
+	for _, file := range files {
+		name := strings.TrimSuffix(file.Name(), templateSuffix)
+		tmpl, err := template.New(name).Funcs(TemplateFuncMap).ParseFS(templateFS, path.Join("templates", file.Name()))
+		if err != nil {
+			return nil, fmt.Errorf("error parsing template %s: %w", file.Name(), err)
+		}
+		templates[name] = tmpl.Lookup(file.Name())
+	}
+	return templates, nil
+})
+
+// NewSOAPTemplateEngine creates a template engine with pre-compiled built-in templates
+func NewSOAPTemplateEngine() (*SOAPTemplateEngine, error) {
+	builtin, err := builtinTemplates()
+	if err != nil {
+		return nil, err
+	}
+
+	engine := &SOAPTemplateEngine{
+		templates: make(map[string]*template.Template, len(builtin)),
+		errors:    make(map[string]error),
+	}
+	for name, tmpl := range builtin {
+		engine.templates[name] = tmpl
+	}
+	return engine, nil
+}
+
+// Render executes the named template with the given data
+func (e *SOAPTemplateEngine) Render(name string, data interface{}) ([]byte, error) {
+	if err := e.errors[name]; err != nil {
+		return nil, err
+	}
+
+	tmpl, ok := e.templates[name]
+	if !ok {
+		return nil, fmt.Errorf("template %s not found", name)
+	}
+
+	var buf bytes.Buffer
+	if err := tmpl.Execute(&buf, data); err != nil {
+		return nil, fmt.Errorf("error rendering template %s: %w", name, err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Has reports whether a template exists for the operation
+func (e *SOAPTemplateEngine) Has(name string) bool {
+	_, ok := e.templates[name]
+	return ok || e.errors[name] != nil
+}
+
+// set parses and stores a template, parse errors are reported by Render
+func (e *SOAPTemplateEngine) set(name, text string) {
+	tmpl, err := template.New(name).Funcs(TemplateFuncMap).Parse(text)
+	if err != nil {
+		e.errors[name] = fmt.Errorf("error parsing template %s: %w", name, err)
+		return
+	}
+	delete(e.errors, name)
+	e.templates[name] = tmpl
+}
+
+// WithCustomTemplate overrides the SOAP request template for an operation
+// Operations with a custom template are rendered from it instead of being marshaled with encoding/xml
+// The template receives .Namespace (DHL24 SOAP namespace) and .Request (the operation request struct)
+func WithCustomTemplate(operation, templateStr string) Option {
+	return func(c *Client) {
+		c.templates.set(operation, templateStr)
+		c.customTemplates[operation] = true
+	}
+}
+
+// formatDate formats a time.Time or passes through a date string in DHL24 format (YYYY-MM-DD)
+func formatTemplateDate(v interface{}) string {
+	switch d := v.(type) {
+	case time.Time:
+		return d.Format("2006-01-02")
+	case string:
+		return escapeXML(d)
+	default:
+		return escapeXML(fmt.Sprint(v))
+	}
+}
+
+// formatWeight formats a weight in kilograms without trailing zeros
+func formatTemplateWeight(weight float64) string {
+	return strconv.FormatFloat(weight, 'f', -1, 64)
+}
@@ -0,0 +1,132 @@
+package dhl
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/sync/semaphore"
+)
+
+// TrackingEvent is a single tracking event of a shipment
+type TrackingEvent struct {
+	Timestamp   time.Time
+	Location    string
+	Description string
+	// Status is the DHL24 event code, e.g. "DOR" for delivered
+	Status string
+}
+
+// OrderStatus maps the event code to a shipment status
+func (e TrackingEvent) OrderStatus() OrderStatus {
+	if status, ok := eventStatuses[e.Status]; ok {
+		return status
+	}
+	return OrderStatusUnknown
+}
+
+// GetTrackAndTrace returns all tracking events of a shipment in chronological order
+// Documentation: https://dhl24.com.pl/en/webapi2/doc/service/getTrackAndTraceInfo.html
+func (c *Client) GetTrackAndTrace(ctx context.Context, shipmentID ShipmentID) ([]TrackingEvent, *ResponseMeta, error) {
+	info, resp, err := c.getTrackAndTraceInfo(ctx, shipmentID)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	events := make([]TrackingEvent, 0, len(info.Events.Items))
+	for _, event := range info.Events.Items {
+		timestamp, err := time.ParseInLocation(trackingTimeLayout, event.Timestamp, time.Local)
+		if err != nil {
+			return nil, resp, fmt.Errorf("error parsing event timestamp %q: %w", event.Timestamp, err)
+		}
+		events = append(events, TrackingEvent{
+			Timestamp:   timestamp,
+			Location:    event.Terminal,
+			Description: event.Description,
+			Status:      event.Status,
+		})
+	}
+
+	return events, resp, nil
+}
+
+// defaultTrackingConcurrency is the parallelism of GetTrackAndTraceMany when concurrency is not positive
+const defaultTrackingConcurrency = 4
+
+// BatchError lists the shipments that failed in a batch call, results of the other shipments are still returned
+type BatchError struct {
+	Errors map[ShipmentID]error
+}
+
+// Error implements the error interface
+func (e *BatchError) Error() string {
+	ids := make([]string, 0, len(e.Errors))
+	for id := range e.Errors {
+		ids = append(ids, string(id))
+	}
+	slices.Sort(ids)
+
+	messages := make([]string, len(ids))
+	for i, id := range ids {
+		messages[i] = fmt.Sprintf("%s: %v", id, e.Errors[ShipmentID(id)])
+	}
+	return fmt.Sprintf("%d shipments failed: %s", len(ids), strings.Join(messages, "; "))
+}
+
+// Unwrap returns the collected errors for errors.Is and errors.As
+func (e *BatchError) Unwrap() []error {
+	errs := make([]error, 0, len(e.Errors))
+	for _, err := range e.Errors {
+		errs = append(errs, err)
+	}
+	return errs
+}
+
+// GetTrackAndTraceMany fetches tracking events of several shipments, running at most concurrency requests at once
+// Shipments that fail are missing from the result and listed in the returned *BatchError
+func (c *Client) GetTrackAndTraceMany(ctx context.Context, ids []ShipmentID, concurrency int) (map[ShipmentID][]TrackingEvent, error) {
+	if concurrency <= 0 {
+		concurrency = defaultTrackingConcurrency
+	}
+	sem := semaphore.NewWeighted(int64(concurrency))
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		results = make(map[ShipmentID][]TrackingEvent, len(ids))
+		failed  = make(map[ShipmentID]error)
+	)
+	for _, id := range ids {
+		if err := sem.Acquire(ctx, 1); err != nil {
+			mu.Lock()
+			failed[id] = err
+			mu.Unlock()
+			continue
+		}
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			defer sem.Release(1)
+
+			events, _, err := c.GetTrackAndTrace(ctx, id)
+
+			mu.Lock()
+			defer mu.Unlock()
+			if err != nil {
+				failed[id] = err
+				return
+			}
+			results[id] = events
+		}()
+	}
+	wg.Wait()
+
+	if len(failed) > 0 {
+		return results, &BatchError{Errors: failed}
+	}
+	return results, nil
+}
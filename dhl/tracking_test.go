@@ -0,0 +1,37 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestGetTrackAndTraceManyPartialFailure(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "<shipmentId>2</shipmentId>") {
+			io.WriteString(w, `<Envelope><Body><Fault><faultcode>101</faultcode><faultstring>unknown shipment</faultstring></Fault></Body></Envelope>`)
+			return
+		}
+		io.WriteString(w, `<Envelope><Body><getTrackAndTraceInfoResponse><getTrackAndTraceInfoResult><shipmentId>1</shipmentId></getTrackAndTraceInfoResult></getTrackAndTraceInfoResponse></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL))
+	results, err := client.GetTrackAndTraceMany(context.Background(), []ShipmentID{"1", "2", "3"}, 2)
+
+	var batchErr *BatchError
+	if !errors.As(err, &batchErr) {
+		t.Fatalf("expected *BatchError, got %v", err)
+	}
+	if len(batchErr.Errors) != 1 || batchErr.Errors["2"] == nil {
+		t.Errorf("failed = %v, want only shipment 2", batchErr.Errors)
+	}
+	if _, ok := results["1"]; !ok || len(results) != 2 {
+		t.Errorf("results = %v, want shipments 1 and 3", results)
+	}
+}
@@ -0,0 +1,39 @@
+package dhl
+
+import (
+	"crypto/tls"
+	"net/http"
+)
+
+// WithTLSConfig sets the TLS configuration of the default transport, e.g. a client certificate for mutual TLS
+// It is ignored when WithTransport is used, regardless of option order
+func WithTLSConfig(config *tls.Config) Option {
+	return func(c *Client) {
+		if c.customTransport {
+			c.logger.Warn("TLS config ignored, client uses a custom transport")
+			return
+		}
+
+		transport := configurableTransport(&c.httpClient.Transport)
+		if transport == nil {
+			c.logger.Warn("TLS config ignored, client transport is not an *http.Transport")
+			return
+		}
+		transport.TLSClientConfig = config
+	}
+}
+
+// WithTransport replaces the transport used for API calls, e.g. an *http.Transport carrying a client certificate
+// It overrides WithTLSConfig, credential wrapping by WithAutoRefreshCredentials is kept
+func WithTransport(transport http.RoundTripper) Option {
+	return func(c *Client) {
+		c.customTransport = true
+		c.resolvingDialer = nil
+
+		if auth, ok := c.httpClient.Transport.(*AuthenticatedTransport); ok {
+			auth.Base = transport
+			return
+		}
+		c.httpClient.Transport = transport
+	}
+}
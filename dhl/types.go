@@ -28,15 +28,31 @@ type SOAPResponseEnvelope struct {
 
 // SOAPResponseBody wraps the response content
 type SOAPResponseBody struct {
-	GetVersionResponse      *GetVersionResponse      `xml:"getVersionResponse,omitempty"`
-	CreateShipmentsResponse *CreateShipmentsResponse `xml:"createShipmentsResponse,omitempty"`
-	GetMyShipmentsResponse  *GetMyShipmentsResponse  `xml:"getMyShipmentsResponse,omitempty"`
+	GetVersionResponse            *GetVersionResponse            `xml:"getVersionResponse,omitempty"`
+	CreateShipmentsResponse       *CreateShipmentsResponse       `xml:"createShipmentsResponse,omitempty"`
+	GetMyShipmentsResponse        *GetMyShipmentsResponse        `xml:"getMyShipmentsResponse,omitempty"`
+	GetLabelsResponse             *GetLabelsResponse             `xml:"getLabelsResponse,omitempty"`
+	DeleteShipmentsResponse       *DeleteShipmentsResponse       `xml:"deleteShipmentsResponse,omitempty"`
+	GetTrackAndTraceInfoResponse  *GetTrackAndTraceInfoResponse  `xml:"getTrackAndTraceInfoResponse,omitempty"`
+	GetPostalCodeServicesResponse *GetPostalCodeServicesResponse `xml:"getPostalCodeServicesResponse,omitempty"`
+	GetMyShipmentsCountResponse   *GetMyShipmentsCountResponse   `xml:"getMyShipmentsCountResponse,omitempty"`
+	BookCourierResponse           *BookCourierResponse           `xml:"bookCourierResponse,omitempty"`
+	GetPriceResponse              *GetPriceResponse              `xml:"getPriceResponse,omitempty"`
 }
 
 // ============================================================================
 // Common Types
 // ============================================================================
 
+// CountryCode represents an ISO 3166-1 alpha-2 country code (e.g. "PL")
+type CountryCode string
+
+// ServiceCode represents a DHL24 service product code (e.g. "AH")
+type ServiceCode string
+
+// ShipmentID is a DHL24 shipment identifier, use ParseShipmentID to validate user input
+type ShipmentID string
+
 // AuthData contains authentication credentials
 type AuthData struct {
 	Username string `xml:"username"`
@@ -45,16 +61,25 @@ type AuthData struct {
 
 // Address represents shipper or receiver address
 type Address struct {
-	Country         string `xml:"country,omitempty"`
-	Name            string `xml:"name"`
-	PostalCode      string `xml:"postalCode"`
-	City            string `xml:"city"`
-	Street          string `xml:"street"`
-	HouseNumber     string `xml:"houseNumber"`
-	ApartmentNumber string `xml:"apartmentNumber,omitempty"`
-	ContactPerson   string `xml:"contactPerson,omitempty"`
-	ContactPhone    string `xml:"contactPhone"`
-	ContactEmail    string `xml:"contactEmail"`
+	Country         string `xml:"country,omitempty" json:"country,omitempty"`
+	Name            string `xml:"name" json:"name"`
+	PostalCode      string `xml:"postalCode" json:"postalCode"`
+	City            string `xml:"city" json:"city"`
+	Street          string `xml:"street" json:"street"`
+	HouseNumber     string `xml:"houseNumber" json:"houseNumber"`
+	ApartmentNumber string `xml:"apartmentNumber,omitempty" json:"apartmentNumber,omitempty"`
+	// State is the voivodeship or region, it is not sent to DHL24
+	State         string `xml:"-" json:"state,omitempty"`
+	ContactPerson string `xml:"contactPerson,omitempty" json:"contactPerson,omitempty"`
+	ContactPhone  string `xml:"contactPhone" json:"contactPhone"`
+	ContactEmail  string `xml:"contactEmail" json:"contactEmail"`
+}
+
+// ContactInfo contains contact details of a shipper or receiver
+type ContactInfo struct {
+	Person string `json:"contactPerson,omitempty"`
+	Phone  string `json:"contactPhone"`
+	Email  string `json:"contactEmail"`
 }
 
 // Piece represents a single piece in a shipment
@@ -62,6 +87,10 @@ type Piece struct {
 	Type     string  `xml:"type"`
 	Quantity int     `xml:"quantity"`
 	Weight   float64 `xml:"weight"`
+	// Width, Height and Length are in cm, DHL24 requires them for packages
+	Width  int `xml:"width,omitempty"`
+	Height int `xml:"height,omitempty"`
+	Length int `xml:"length,omitempty"`
 }
 
 // PieceList contains list of pieces
@@ -71,17 +100,41 @@ type PieceList struct {
 
 // Payment contains payment information
 type Payment struct {
-	PaymentType   string `xml:"paymentType"`
-	PayerType     string `xml:"payerType"`
-	AccountNumber string `xml:"accountNumber"`
-	PaymentMethod string `xml:"paymentMethod"`
+	PaymentType   string              `xml:"paymentType"`
+	PayerType     ShippingPaymentType `xml:"payerType"`
+	AccountNumber string              `xml:"accountNumber"`
+	PaymentMethod string              `xml:"paymentMethod"`
 }
 
 // Service contains service/product information
 type Service struct {
-	Product string `xml:"product"`
+	Product ProductCode `xml:"product"`
 }
 
+// DropOffType represents how the shipment is handed over to DHL
+type DropOffType string
+
+const (
+	// DropOffRegularPickup is a pickup by the courier on a regular route
+	DropOffRegularPickup DropOffType = "REGULAR_PICKUP"
+	// DropOffCustomerService is a drop-off at a DHL customer service point
+	DropOffCustomerService DropOffType = "CUSTOMER_SERVICE"
+	// DropOffServicePoint is a drop-off at a DHL ServicePoint
+	DropOffServicePoint DropOffType = "SERVICE_POINT"
+)
+
+// ShippingPaymentType represents the party paying for the shipment
+type ShippingPaymentType string
+
+const (
+	// PaymentTypeShipper means the shipper pays
+	PaymentTypeShipper ShippingPaymentType = "SHIPPER"
+	// PaymentTypeReceiver means the receiver pays
+	PaymentTypeReceiver ShippingPaymentType = "RECEIVER"
+	// PaymentTypeThirdParty means a third party pays
+	PaymentTypeThirdParty ShippingPaymentType = "USER"
+)
+
 // ============================================================================
 // GetVersion Types
 // ============================================================================
@@ -123,8 +176,83 @@ type ShipmentItem struct {
 	SkipRestrictionCheck bool      `xml:"skipRestrictionCheck"`
 	Comment              string    `xml:"comment"`
 	Content              string    `xml:"content"`
+	// Reference is the customer reference stored with the shipment, DHL24 has no lookup by reference
+	Reference string `xml:"reference,omitempty"`
+	// Customs is the customs declaration, required for international shipments outside the EU customs union
+	Customs *CustomsData `xml:"customs,omitempty"`
+	// ServiceAddons are sent in shipmentInfo.specialServices, add-ons needing a value must be set there directly
+	ServiceAddons []ServiceAddon `xml:"-"`
+	// ShipmentInfo is optional and only sent when set
+	ShipmentInfo *ShipmentInfo `xml:"shipmentInfo,omitempty"`
+}
+
+// CustomsData contains the customs declaration of an international shipment
+type CustomsData struct {
+	// CustomsType is the DHL24 declaration type
+	CustomsType   string        `xml:"customsType"`
+	InvoiceNumber string        `xml:"invoiceNr"`
+	InvoiceValue  float64       `xml:"invoiceValue"`
+	Currency      string        `xml:"currency"`
+	Items         []CustomsItem `xml:"customsItem>item"`
+}
+
+// CustomsItem is a single declared item of a customs declaration
+type CustomsItem struct {
+	Description     string  `xml:"nameEn"`
+	Quantity        int     `xml:"quantity"`
+	Weight          float64 `xml:"weight"` // kg, total for the quantity
+	Value           float64 `xml:"value"`  // total for the quantity, in CustomsData.Currency
+	HSCode          string  `xml:"tariffCode"`
+	CountryOfOrigin string  `xml:"countryOfOrigin"`
+}
+
+// ShipmentInfo contains the WSDL shipmentInfo block: drop-off, service, billing, pickup time and label settings
+// Empty fields are not sent
+type ShipmentInfo struct {
+	DropOffType     DropOffType      `xml:"dropOffType,omitempty"`
+	ServiceType     string           `xml:"serviceType,omitempty"`
+	Billing         *Billing         `xml:"billing,omitempty"`
+	SpecialServices *SpecialServices `xml:"specialServices,omitempty"`
+	ShipmentTime    *ShipmentTime    `xml:"shipmentTime,omitempty"`
+	LabelType       LabelType        `xml:"labelType,omitempty"`
+}
+
+// Billing contains payment settings of the shipmentInfo block
+type Billing struct {
+	ShippingPaymentType  ShippingPaymentType `xml:"shippingPaymentType"`
+	BillingAccountNumber string              `xml:"billingAccountNumber"`
+	PaymentType          string              `xml:"paymentType"`
+	CostsCenter          string              `xml:"costsCenter,omitempty"`
+}
+
+// SpecialServices contains list of additional services
+type SpecialServices struct {
+	Items []SpecialService `xml:"item"`
+}
+
+// SpecialService is an additional service such as insurance (UBEZP) or cash on delivery (COD)
+type SpecialService struct {
+	ServiceType     ServiceAddon `xml:"serviceType"`
+	ServiceValue    string       `xml:"serviceValue,omitempty"`
+	TextInstruction string       `xml:"textInstruction,omitempty"`
+	// CollectOnDeliveryForm is how the receiver pays a COD amount, e.g. BANK_TRANSFER
+	CollectOnDeliveryForm string `xml:"collectOnDeliveryForm,omitempty"`
+}
+
+// ShipmentTime contains the shipment date and the pickup hours (HH:MM)
+type ShipmentTime struct {
+	ShipmentDate      string `xml:"shipmentDate"`
+	ShipmentStartHour string `xml:"shipmentStartHour"`
+	ShipmentEndHour   string `xml:"shipmentEndHour"`
 }
 
+// ShipmentRequest holds everything needed to create a shipment: addresses, pieces, payment,
+// service product, shipment date, content and the skip-restriction flag
+// It is an alias rather than a distinct type on purpose: createShipments and getPrice take
+// the same wire item, so the request is sent as-is and keeps the ShipmentItem methods
+// (Validate, config defaults, addons) without conversions
+type ShipmentRequest = ShipmentItem
+
 // CreateShipmentsResponse represents createShipments SOAP response
 type CreateShipmentsResponse struct {
 	Result CreateShipmentsResult `xml:"createShipmentsResult"`
@@ -140,6 +268,12 @@ type CreatedShipment struct {
 	ShipmentID  string `xml:"shipmentId"`
 	ShipmentNo  string `xml:"shipmentNo,omitempty"`
 	OrderStatus string `xml:"orderStatus,omitempty"`
+	// WaybillNumber is the customer-facing tracking number
+	WaybillNumber    string `xml:"waybillNumber,omitempty"`
+	LabelNumber      string `xml:"labelNumber,omitempty"`
+	ReturnShipmentID string `xml:"returnShipmentId,omitempty"`
+	// EstimatedDeliveryDate is in YYYY-MM-DD format, use DeliveryDate to parse it
+	EstimatedDeliveryDate string `xml:"estimatedDeliveryDate,omitempty"`
 }
 
 // ============================================================================
@@ -176,13 +310,28 @@ type GetMyShipmentsResult struct {
 	Items []ShipmentBasicData `xml:"item"`
 }
 
+// GetMyShipmentsCountRequest represents getMyShipmentsCount SOAP request
+type GetMyShipmentsCountRequest struct {
+	XMLName     xml.Name `xml:"ns:getMyShipmentsCount"`
+	AuthData    AuthData `xml:"authData"`
+	CreatedFrom string   `xml:"createdFrom"`
+	CreatedTo   string   `xml:"createdTo"`
+}
+
+// GetMyShipmentsCountResponse represents getMyShipmentsCount SOAP response
+type GetMyShipmentsCountResponse struct {
+	Count int `xml:"getMyShipmentsCountResult"`
+}
+
 // ShipmentBasicData represents basic shipment information
 type ShipmentBasicData struct {
-	ShipmentID  string      `xml:"shipmentId"`
-	Created     string      `xml:"created"`
-	Shipper     AddressInfo `xml:"shipper"`
-	Receiver    AddressInfo `xml:"receiver"`
-	OrderStatus string      `xml:"orderStatus"`
+	ShipmentID string `xml:"shipmentId"`
+	Created    string `xml:"created"` // "2006-01-02 15:04:05", parsed by CreatedAt
+	// WaybillNumber is the customer-facing tracking number, empty when DHL does not return it
+	WaybillNumber string      `xml:"waybillNumber,omitempty"`
+	Shipper       AddressInfo `xml:"shipper"`
+	Receiver      AddressInfo `xml:"receiver"`
+	OrderStatus   string      `xml:"orderStatus"`
 }
 
 // AddressInfo represents address information for shipper or receiver (response)
@@ -197,3 +346,207 @@ type AddressInfo struct {
 	ContactPhone    string `xml:"contactPhone"`
 	ContactEmail    string `xml:"contactEmail"`
 }
+
+// ============================================================================
+// GetLabels Types
+// ============================================================================
+
+// LabelType represents the DHL24 label document type
+type LabelType string
+
+const (
+	// LabelTypeLP is the waybill (list przewozowy) in PDF format
+	LabelTypeLP LabelType = "LP"
+	// LabelTypeBLP is the BLP label in PDF format
+	LabelTypeBLP LabelType = "BLP"
+	// LabelTypeZPL is the BLP label in ZPL format for Zebra printers
+	LabelTypeZPL LabelType = "ZBLP"
+)
+
+// GetLabelsRequest represents getLabels SOAP request
+type GetLabelsRequest struct {
+	XMLName      xml.Name     `xml:"ns:getLabels"`
+	AuthData     AuthData     `xml:"authData"`
+	ItemsToPrint ItemsToPrint `xml:"itemsToPrint"`
+}
+
+// ItemsToPrint contains list of labels to retrieve
+type ItemsToPrint struct {
+	Items []ItemToPrint `xml:"item"`
+}
+
+// ItemToPrint identifies a single label to retrieve
+type ItemToPrint struct {
+	LabelType  LabelType `xml:"labelType"`
+	ShipmentID string    `xml:"shipmentId"`
+}
+
+// GetLabelsResponse represents getLabels SOAP response
+type GetLabelsResponse struct {
+	Result GetLabelsResult `xml:"getLabelsResult"`
+}
+
+// GetLabelsResult contains retrieved labels
+type GetLabelsResult struct {
+	Items []Label `xml:"item"`
+}
+
+// Label represents a single label document
+type Label struct {
+	ShipmentID    string    `xml:"shipmentId"`
+	LabelType     LabelType `xml:"labelType"`
+	LabelName     string    `xml:"labelName"`
+	LabelData     string    `xml:"labelData"`
+	LabelMimeType string    `xml:"labelMimeType"`
+}
+
+// ============================================================================
+// DeleteShipments Types
+// ============================================================================
+
+// DeleteShipmentsRequest represents deleteShipments SOAP request
+type DeleteShipmentsRequest struct {
+	XMLName   xml.Name    `xml:"ns:deleteShipments"`
+	AuthData  AuthData    `xml:"authData"`
+	Shipments ShipmentIDs `xml:"shipments"`
+}
+
+// ShipmentIDs contains list of shipment identifiers
+type ShipmentIDs struct {
+	Items []string `xml:"item"`
+}
+
+// DeleteShipmentsResponse represents deleteShipments SOAP response
+type DeleteShipmentsResponse struct {
+	Result DeleteShipmentsResult `xml:"deleteShipmentsResult"`
+}
+
+// DeleteShipmentsResult contains results for each deleted shipment
+type DeleteShipmentsResult struct {
+	Items []DeletedShipment `xml:"item"`
+}
+
+// DeletedShipment represents the result of deleting a single shipment
+type DeletedShipment struct {
+	ID     string `xml:"id"`
+	Result bool   `xml:"result"`
+	Error  string `xml:"error"`
+}
+
+// DeleteResult is the outcome of cancelling one shipment with DeleteShipments
+type DeleteResult struct {
+	ShipmentID ShipmentID
+	Success    bool
+	// Error is set when the shipment was not cancelled
+	Error *SOAPFault
+}
+
+// ============================================================================
+// GetTrackAndTraceInfo Types
+// ============================================================================
+
+// GetTrackAndTraceInfoRequest represents getTrackAndTraceInfo SOAP request
+type GetTrackAndTraceInfoRequest struct {
+	XMLName    xml.Name `xml:"ns:getTrackAndTraceInfo"`
+	AuthData   AuthData `xml:"authData"`
+	ShipmentID string   `xml:"shipmentId"`
+}
+
+// GetTrackAndTraceInfoResponse represents getTrackAndTraceInfo SOAP response
+type GetTrackAndTraceInfoResponse struct {
+	Result TrackAndTraceInfo `xml:"getTrackAndTraceInfoResult"`
+}
+
+// TrackAndTraceInfo contains tracking events of a shipment
+type TrackAndTraceInfo struct {
+	ShipmentID string              `xml:"shipmentId"`
+	ReceivedBy string              `xml:"receivedBy"`
+	Events     TrackAndTraceEvents `xml:"events"`
+}
+
+// TrackAndTraceEvents contains list of tracking events
+type TrackAndTraceEvents struct {
+	Items []TrackAndTraceEvent `xml:"item"`
+}
+
+// TrackAndTraceEvent represents a single tracking event (response)
+type TrackAndTraceEvent struct {
+	Status      string `xml:"status"`
+	Description string `xml:"description"`
+	Terminal    string `xml:"terminal"`
+	Timestamp   string `xml:"timestamp"`
+}
+
+// ============================================================================
+// GetPostalCodeServices Types
+// ============================================================================
+
+// GetPostalCodeServicesRequest represents getPostalCodeServices SOAP request
+type GetPostalCodeServicesRequest struct {
+	XMLName    xml.Name `xml:"ns:getPostalCodeServices"`
+	AuthData   AuthData `xml:"authData"`
+	PostCode   string   `xml:"postCode"`
+	PickupDate string   `xml:"pickupDate"`
+}
+
+// GetPostalCodeServicesResponse represents getPostalCodeServices SOAP response
+type GetPostalCodeServicesResponse struct {
+	Result PostalCodeServices `xml:"getPostalCodeServicesResult"`
+}
+
+// PostalCodeServices contains services and pickup hours available for a postal code
+type PostalCodeServices struct {
+	DomesticExpress9  bool   `xml:"domesticExpress9"`
+	DomesticExpress12 bool   `xml:"domesticExpress12"`
+	DeliveryEvening   bool   `xml:"deliveryEvening"`
+	PickupOnSaturday  bool   `xml:"pickupOnSaturday"`
+	DeliverySaturday  bool   `xml:"deliverySaturday"`
+	ExPickupFrom      string `xml:"exPickupFrom"`
+	ExPickupTo        string `xml:"exPickupTo"`
+	DrPickupFrom      string `xml:"drPickupFrom"`
+	DrPickupTo        string `xml:"drPickupTo"`
+}
+
+// ============================================================================
+// BookCourier Types
+// ============================================================================
+
+// BookCourierRequest represents bookCourier SOAP request
+type BookCourierRequest struct {
+	XMLName          xml.Name    `xml:"ns:bookCourier"`
+	AuthData         AuthData    `xml:"authData"`
+	PickupDate       string      `xml:"pickupDate"`
+	PickupTimeFrom   string      `xml:"pickupTimeFrom"`
+	PickupTimeTo     string      `xml:"pickupTimeTo"`
+	AdditionalInfo   string      `xml:"additionalInfo,omitempty"`
+	ShipmentIDList   ShipmentIDs `xml:"shipmentIdList"`
+	CourierWithLabel bool        `xml:"courierWithLabel"`
+}
+
+// BookCourierResponse represents bookCourier SOAP response
+type BookCourierResponse struct {
+	Result ShipmentIDs `xml:"bookCourierResult"`
+}
+
+// ============================================================================
+// GetPrice Types
+// ============================================================================
+
+// GetPriceRequest represents getPrice SOAP request
+type GetPriceRequest struct {
+	XMLName  xml.Name     `xml:"ns:getPrice"`
+	AuthData AuthData     `xml:"authData"`
+	Shipment ShipmentItem `xml:"shipment"`
+}
+
+// GetPriceResponse represents getPrice SOAP response
+type GetPriceResponse struct {
+	Result PriceResult `xml:"getPriceResult"`
+}
+
+// PriceResult contains the net price quote of a shipment in PLN
+type PriceResult struct {
+	Price         float64 `xml:"price"`
+	FuelSurcharge float64 `xml:"fuelSurcharge"`
+	FuelCharge    float64 `xml:"fuelCharge"`
+}
@@ -0,0 +1,207 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+// DryRunOrderStatus is the order status of shipments returned by CreateShipments in dry-run mode
+const DryRunOrderStatus = "DRY_RUN"
+
+// FieldError is a single validation failure, Field is empty when the API did not name the field
+type FieldError struct {
+	Field   string
+	Message string
+}
+
+// Error implements the error interface
+func (e FieldError) Error() string {
+	if e.Field == "" {
+		return e.Message
+	}
+	return e.Field + ": " + e.Message
+}
+
+// ValidationErrors lists the field violations of a shipment returned by ValidateShipment
+type ValidationErrors []FieldError
+
+// Error implements the error interface
+func (e ValidationErrors) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return "shipment validation failed: " + strings.Join(msgs, "; ")
+}
+
+// WithDryRunMode makes CreateShipments validate shipments with ValidateShipment instead of creating them
+// Results have an empty ShipmentID and OrderStatus set to DryRunOrderStatus
+func WithDryRunMode() Option {
+	return func(c *Client) {
+		c.dryRun = true
+	}
+}
+
+// ValidateShipment checks a shipment without creating a booking
+// DHL24 has no dry-run flag for createShipments, so the shipment is checked locally with Validate
+// then priced with getPrice: a returned price means the API accepts it
+// Returns ValidationErrors for invalid shipments, other errors are passed through
+func (c *Client) ValidateShipment(ctx context.Context, item ShipmentItem) error {
+	if item.Receiver.Country == "" {
+		item.Receiver.Country = string(c.defaultCountry)
+	}
+
+	if err := item.Validate(); err != nil {
+		return err
+	}
+
+	_, _, err := c.GetPrice(ctx, item)
+	var fault *SOAPFault
+	if errors.As(err, &fault) {
+		return ValidationErrors{{Message: fault.Message}}
+	}
+	return err
+}
+
+// createShipmentsDryRun validates shipments and returns placeholder results instead of creating them
+func (c *Client) createShipmentsDryRun(ctx context.Context, reqs []ShipmentRequest) ([]CreatedShipment, *ResponseMeta, error) {
+	results := make([]CreatedShipment, len(reqs))
+	for i, req := range reqs {
+		if err := c.ValidateShipment(ctx, req); err != nil {
+			return nil, nil, fmt.Errorf("error validating shipment %d: %w", i, err)
+		}
+		results[i] = CreatedShipment{OrderStatus: DryRunOrderStatus}
+	}
+	return results, nil, nil
+}
+
+// strictPolishPostalCodePattern is the NN-NNN format required by ShipmentItem.Validate
+var strictPolishPostalCodePattern = regexp.MustCompile(`^\d{2}-\d{3}$`)
+
+// Validate checks the shipment before it is sent, returning ValidationErrors with all violations
+// Shipper and receiver need name, postal code, city, street, house number and phone, the receiver also a country;
+// pieces need weight > 0 and quantity >= 1, the date must not be in the past,
+// the product must be in ValidProductCodes and Polish postal codes must be in NN-NNN format
+func (s ShipmentItem) Validate() error {
+	if errs := s.fieldErrors(); len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// fieldErrors collects the violations reported by Validate
+func (s ShipmentItem) fieldErrors() ValidationErrors {
+	var errs ValidationErrors
+	errs = append(errs, addressFieldErrors("shipper", s.Shipper)...)
+	errs = append(errs, addressFieldErrors("receiver", s.Receiver)...)
+	if s.Receiver.Country == "" {
+		errs = append(errs, FieldError{Field: "receiver.country", Message: "is required"})
+	}
+
+	if len(s.PieceList.Items) == 0 {
+		errs = append(errs, FieldError{Field: "pieceList", Message: "at least one piece is required"})
+	}
+	for i, piece := range s.PieceList.Items {
+		field := fmt.Sprintf("pieceList.item[%d]", i)
+		if piece.Type == "" {
+			errs = append(errs, FieldError{Field: field + ".type", Message: "is required"})
+		}
+		if piece.Quantity < 1 {
+			errs = append(errs, FieldError{Field: field + ".quantity", Message: "must be at least 1"})
+		}
+		if piece.Weight <= 0 {
+			errs = append(errs, FieldError{Field: field + ".weight", Message: "must be positive"})
+		}
+	}
+
+	if s.Service.Product == "" {
+		errs = append(errs, FieldError{Field: "service.product", Message: "is required"})
+	} else if !s.Service.Product.IsValid() {
+		errs = append(errs, FieldError{Field: "service.product", Message: fmt.Sprintf("unknown product code %q", s.Service.Product)})
+	}
+
+	if s.Service.Product.IsValid() {
+		errs = append(errs, s.addonFieldErrors()...)
+	}
+	if s.Customs != nil {
+		errs = append(errs, customsFieldErrors(*s.Customs)...)
+	}
+
+	date, err := time.ParseInLocation("2006-01-02", s.ShipmentDate, time.Local)
+	if err != nil {
+		errs = append(errs, FieldError{Field: "shipmentDate", Message: fmt.Sprintf("invalid date %q, expected YYYY-MM-DD", s.ShipmentDate)})
+	} else if y, m, d := time.Now().Date(); date.Before(time.Date(y, m, d, 0, 0, 0, 0, time.Local)) {
+		errs = append(errs, FieldError{Field: "shipmentDate", Message: fmt.Sprintf("date %s is in the past", s.ShipmentDate)})
+	}
+
+	return errs
+}
+
+// hsCodePattern matches a Harmonized System code of 6 to 10 digits
+var hsCodePattern = regexp.MustCompile(`^\d{6,10}$`)
+
+// customsFieldErrors checks the customs declaration and its items
+func customsFieldErrors(c CustomsData) ValidationErrors {
+	var errs ValidationErrors
+	if c.CustomsType == "" {
+		errs = append(errs, FieldError{Field: "customs.customsType", Message: "is required"})
+	}
+	if c.InvoiceValue <= 0 {
+		errs = append(errs, FieldError{Field: "customs.invoiceValue", Message: "must be positive"})
+	}
+	if c.Currency == "" {
+		errs = append(errs, FieldError{Field: "customs.currency", Message: "is required"})
+	}
+	if len(c.Items) == 0 {
+		errs = append(errs, FieldError{Field: "customs.customsItem", Message: "at least one item is required"})
+	}
+	for i, item := range c.Items {
+		field := fmt.Sprintf("customs.customsItem[%d]", i)
+		if item.Description == "" {
+			errs = append(errs, FieldError{Field: field + ".nameEn", Message: "is required"})
+		}
+		if item.Quantity < 1 {
+			errs = append(errs, FieldError{Field: field + ".quantity", Message: "must be at least 1"})
+		}
+		if item.Weight <= 0 {
+			errs = append(errs, FieldError{Field: field + ".weight", Message: "must be positive"})
+		}
+		if !hsCodePattern.MatchString(item.HSCode) {
+			errs = append(errs, FieldError{Field: field + ".tariffCode", Message: fmt.Sprintf("invalid HS code %q, expected 6 to 10 digits", item.HSCode)})
+		}
+		if len(item.CountryOfOrigin) != 2 {
+			errs = append(errs, FieldError{Field: field + ".countryOfOrigin", Message: "must be an ISO 3166-1 alpha-2 code"})
+		}
+	}
+	return errs
+}
+
+// addressFieldErrors checks required address fields, naming fields with the address role prefix
+func addressFieldErrors(prefix string, a Address) ValidationErrors {
+	var errs ValidationErrors
+	required := []struct {
+		name  string
+		value string
+	}{
+		{"name", a.Name},
+		{"postalCode", a.PostalCode},
+		{"city", a.City},
+		{"street", a.Street},
+		{"houseNumber", a.HouseNumber},
+		{"contactPhone", a.ContactPhone},
+	}
+	for _, field := range required {
+		if field.value == "" {
+			errs = append(errs, FieldError{Field: prefix + "." + field.name, Message: "is required"})
+		}
+	}
+
+	if a.PostalCode != "" && a.EffectiveCountry() == "PL" && !strictPolishPostalCodePattern.MatchString(a.PostalCode) {
+		errs = append(errs, FieldError{Field: prefix + ".postalCode", Message: fmt.Sprintf("invalid Polish postal code %q, expected NN-NNN", a.PostalCode)})
+	}
+	return errs
+}
@@ -0,0 +1,92 @@
+package dhl
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func validShipment() ShipmentItem {
+	address := Address{
+		Country:      "PL",
+		Name:         "Name",
+		PostalCode:   "00-001",
+		City:         "Warszawa",
+		Street:       "Street",
+		HouseNumber:  "1",
+		ContactPhone: "123456789",
+	}
+	return ShipmentItem{
+		Shipper:      address,
+		Receiver:     address,
+		PieceList:    PieceList{Items: []Piece{{Type: "PACKAGE", Quantity: 1, Weight: 1}}},
+		Service:      Service{Product: "AH"},
+		ShipmentDate: time.Now().Format("2006-01-02"),
+	}
+}
+
+func TestShipmentValidate(t *testing.T) {
+	if err := validShipment().Validate(); err != nil {
+		t.Fatalf("valid shipment: %v", err)
+	}
+
+	item := validShipment()
+	item.Shipper.ContactPhone = ""
+	item.Receiver.Country = ""
+	item.Receiver.PostalCode = "00001"
+	item.PieceList.Items[0].Weight = 0
+	item.PieceList.Items[0].Quantity = 0
+	item.Service.Product = "XX"
+	item.ShipmentDate = time.Now().AddDate(0, 0, -1).Format("2006-01-02")
+
+	var errs ValidationErrors
+	if !errors.As(item.Validate(), &errs) {
+		t.Fatalf("expected ValidationErrors, got %v", item.Validate())
+	}
+
+	want := []string{
+		"shipper.contactPhone",
+		"receiver.postalCode",
+		"receiver.country",
+		"pieceList.item[0].quantity",
+		"pieceList.item[0].weight",
+		"service.product",
+		"shipmentDate",
+	}
+	if len(errs) != len(want) {
+		t.Fatalf("got %d errors, want %d: %v", len(errs), len(want), errs)
+	}
+	for i, field := range want {
+		if errs[i].Field != field {
+			t.Errorf("error %d field = %q, want %q", i, errs[i].Field, field)
+		}
+	}
+}
+
+func TestShipmentValidateCustoms(t *testing.T) {
+	item := validShipment()
+	item.Receiver.Country = "US"
+	item.Customs = &CustomsData{
+		CustomsType:   "S",
+		InvoiceNumber: "FV/1/2026",
+		InvoiceValue:  120,
+		Currency:      "EUR",
+		Items: []CustomsItem{
+			{Description: "Ceramic mug", Quantity: 2, Weight: 0.8, Value: 120, HSCode: "691200", CountryOfOrigin: "PL"},
+		},
+	}
+	if err := item.Validate(); err != nil {
+		t.Fatalf("valid customs: %v", err)
+	}
+
+	item.Customs.Items[0].HSCode = "69.12"
+	item.Customs.Items[0].CountryOfOrigin = "POL"
+
+	var errs ValidationErrors
+	if !errors.As(item.Validate(), &errs) || len(errs) != 2 {
+		t.Fatalf("expected 2 validation errors, got %v", item.Validate())
+	}
+	if errs[0].Field != "customs.customsItem[0].tariffCode" || errs[1].Field != "customs.customsItem[0].countryOfOrigin" {
+		t.Errorf("unexpected errors: %v", errs)
+	}
+}
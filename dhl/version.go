@@ -0,0 +1,61 @@
+package dhl
+
+import (
+	"context"
+	"regexp"
+	"time"
+)
+
+// versionCacheTTL is how long the API version is cached by GetVersionInfo
+const versionCacheTTL = time.Hour
+
+// versionPattern matches a semantic API version such as "2.5.0"
+var versionPattern = regexp.MustCompile(`^\d+\.\d+\.\d+$`)
+
+// VersionInfo contains the API version reported by getVersion
+type VersionInfo struct {
+	// Version is the raw version string, it may not be in MAJOR.MINOR.PATCH format
+	Version string
+	// Checksum is the Content-MD5 response header, empty when the server does not send it
+	Checksum string
+}
+
+// GetVersionInfo returns the API version, cached for an hour
+// A version not in MAJOR.MINOR.PATCH format is logged as a warning and returned unchanged
+// The response is nil when the version is served from cache
+func (c *Client) GetVersionInfo(ctx context.Context) (*VersionInfo, *ResponseMeta, error) {
+	if info, ok := c.versionCache.get(c.endpoint); ok {
+		return &info, nil, nil
+	}
+
+	version, resp, err := c.GetVersion(ctx)
+	if err != nil {
+		return nil, resp, err
+	}
+
+	if !versionPattern.MatchString(version) {
+		c.logger.Warn("unexpected API version format", "version", version)
+	}
+
+	info := VersionInfo{Version: version}
+	if resp != nil {
+		info.Checksum = resp.Headers.Get("Content-MD5")
+	}
+	c.versionCache.set(c.endpoint, info)
+
+	return &info, resp, nil
+}
+
+// ForceCacheRefresh clears cached results of a SOAP operation, e.g. "getVersion"
+// Supported operations are getVersion, getTrackAndTraceInfo and getLabels,
+// other operations are not cached and are ignored
+func (c *Client) ForceCacheRefresh(operation string) {
+	switch operation {
+	case "getVersion":
+		c.versionCache.clear()
+	case "getTrackAndTraceInfo":
+		c.statusCache.clear()
+	case "getLabels":
+		c.ClearLabelCache()
+	}
+}
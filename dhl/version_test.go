@@ -0,0 +1,38 @@
+package dhl
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetVersionInfoCacheRefresh(t *testing.T) {
+	version := "2.5.0"
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-MD5", "Q2hlY2sgSW50ZWdyaXR5IQ==")
+		fmt.Fprintf(w, `<Envelope><Body><getVersionResponse><getVersionResult>%s</getVersionResult></getVersionResponse></Body></Envelope>`, version)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL))
+
+	info, _, err := client.GetVersionInfo(context.Background())
+	if err != nil {
+		t.Fatalf("get version: %v", err)
+	}
+	if info.Version != "2.5.0" || info.Checksum != "Q2hlY2sgSW50ZWdyaXR5IQ==" {
+		t.Fatalf("info = %+v", info)
+	}
+
+	version = "2.6.0"
+	if info, _, _ = client.GetVersionInfo(context.Background()); info.Version != "2.5.0" {
+		t.Errorf("expected cached version 2.5.0, got %q", info.Version)
+	}
+
+	client.ForceCacheRefresh("getVersion")
+	if info, _, _ = client.GetVersionInfo(context.Background()); info.Version != "2.6.0" {
+		t.Errorf("expected refreshed version 2.6.0, got %q", info.Version)
+	}
+}
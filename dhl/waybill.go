@@ -0,0 +1,47 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"strings"
+)
+
+// ErrShipmentNotFound is returned when no shipment matches the requested number
+var ErrShipmentNotFound = errors.New("shipment not found")
+
+// waybillLookupDays is how far back GetShipmentByWaybillNumber searches
+const waybillLookupDays = 90
+
+// GetShipmentByWaybillNumber finds the shipment booked under a waybill number
+// DHL24 has no lookup operation by waybill, so shipments created in the last 90 days are streamed
+// until one with a matching waybill number is found
+// Shipments returned without a waybill number are matched on shipmentId, which DHL24 uses as the waybill
+func (c *Client) GetShipmentByWaybillNumber(ctx context.Context, waybillNumber string) (*ShipmentBasicData, error) {
+	waybillNumber = strings.TrimSpace(waybillNumber)
+	if waybillNumber == "" {
+		return nil, ErrShipmentNotFound
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	items, errs := c.StreamMyShipments(ctx, LastDays(waybillLookupDays))
+	for shipment := range items {
+		if shipment.waybill() == waybillNumber {
+			return &shipment, nil
+		}
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+
+	return nil, ErrShipmentNotFound
+}
+
+// waybill returns the waybill number of the shipment, falling back to its shipmentId
+func (s ShipmentBasicData) waybill() string {
+	if s.WaybillNumber != "" {
+		return s.WaybillNumber
+	}
+	return s.ShipmentID
+}
@@ -0,0 +1,44 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestGetShipmentByWaybillNumberMatchesWaybill(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		items := `<item><shipmentId>1</shipmentId><waybillNumber>JJD0001</waybillNumber></item>` +
+			`<item><shipmentId>2</shipmentId></item>`
+		fmt.Fprintf(w, `<Envelope><Body><getMyShipmentsResponse><getMyShipmentsResult>%s</getMyShipmentsResult></getMyShipmentsResponse></Body></Envelope>`, items)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL))
+
+	tests := []struct {
+		waybill string
+		want    string
+		err     error
+	}{
+		{waybill: "JJD0001", want: "1"},
+		{waybill: " 2 ", want: "2"},
+		{waybill: "1", err: ErrShipmentNotFound},
+		{waybill: "", err: ErrShipmentNotFound},
+	}
+	for _, tt := range tests {
+		shipment, err := client.GetShipmentByWaybillNumber(context.Background(), tt.waybill)
+		if tt.err != nil {
+			if !errors.Is(err, tt.err) {
+				t.Errorf("GetShipmentByWaybillNumber(%q) error = %v, want %v", tt.waybill, err, tt.err)
+			}
+			continue
+		}
+		if err != nil || shipment.ShipmentID != tt.want {
+			t.Errorf("GetShipmentByWaybillNumber(%q) = %+v, %v, want shipment %s", tt.waybill, shipment, err, tt.want)
+		}
+	}
+}
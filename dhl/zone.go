@@ -0,0 +1,121 @@
+package dhl
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// DateRange represents an inclusive range of dates used for shipment queries
+type DateRange struct {
+	From time.Time
+	To   time.Time
+}
+
+// LastDays returns a DateRange covering the last N days up to today
+func LastDays(days int) DateRange {
+	now := time.Now()
+	return DateRange{
+		From: now.AddDate(0, 0, -days),
+		To:   now,
+	}
+}
+
+// fromString returns the start date in DHL24 format (YYYY-MM-DD)
+func (dr DateRange) fromString() string {
+	return dr.From.Format("2006-01-02")
+}
+
+// toString returns the end date in DHL24 format (YYYY-MM-DD)
+func (dr DateRange) toString() string {
+	return dr.To.Format("2006-01-02")
+}
+
+// PostalCode represents a Polish postal code (e.g. "01-249" or "01249")
+type PostalCode string
+
+// Zone returns the postal code zone - the first 2 digits of the postal code
+// Returns an empty string if the postal code has fewer than 2 digits
+func (p PostalCode) Zone() string {
+	digits := make([]byte, 0, 2)
+	for i := 0; i < len(p) && len(digits) < 2; i++ {
+		if p[i] >= '0' && p[i] <= '9' {
+			digits = append(digits, p[i])
+		}
+	}
+	if len(digits) < 2 {
+		return ""
+	}
+	return string(digits)
+}
+
+// ZoneStat contains shipment count for a single postal code zone
+type ZoneStat struct {
+	Zone       string
+	Count      int
+	Percentage float64
+}
+
+// GetShipmentsByZone retrieves all shipments in the date range and filters them by receiver postal code zone
+// Zone is the first 2 digits of the Polish postal code, e.g. "01" for Warsaw Śródmieście
+// Returns ErrUnknownZone if the zone is not present in the postal zone map
+func (c *Client) GetShipmentsByZone(ctx context.Context, zone string, dr DateRange) ([]ShipmentBasicData, error) {
+	zones, err := c.GetPostalCodeZoneMap(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	zone = strings.TrimSpace(zone)
+	if _, ok := zones[zone]; !ok {
+		return nil, fmt.Errorf("%w: %q", ErrUnknownZone, zone)
+	}
+
+	shipments, err := c.GetAllShipments(ctx, dr)
+	if err != nil {
+		return nil, err
+	}
+
+	var filtered []ShipmentBasicData
+	for _, shipment := range shipments {
+		if PostalCode(shipment.Receiver.PostalCode).Zone() == zone {
+			filtered = append(filtered, shipment)
+		}
+	}
+
+	return filtered, nil
+}
+
+// GroupByZone groups shipments by receiver postal code zone
+func GroupByZone(shipments []ShipmentBasicData) map[string][]ShipmentBasicData {
+	groups := make(map[string][]ShipmentBasicData)
+	for _, shipment := range shipments {
+		zone := PostalCode(shipment.Receiver.PostalCode).Zone()
+		groups[zone] = append(groups[zone], shipment)
+	}
+	return groups
+}
+
+// ZoneStatistics returns shipment counts per receiver postal code zone, sorted by count descending
+func ZoneStatistics(shipments []ShipmentBasicData) []ZoneStat {
+	groups := GroupByZone(shipments)
+
+	stats := make([]ZoneStat, 0, len(groups))
+	for zone, items := range groups {
+		stats = append(stats, ZoneStat{
+			Zone:       zone,
+			Count:      len(items),
+			Percentage: float64(len(items)) * 100 / float64(len(shipments)),
+		})
+	}
+
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].Count != stats[j].Count {
+			return stats[i].Count > stats[j].Count
+		}
+		return stats[i].Zone < stats[j].Zone
+	})
+
+	return stats
+}
@@ -0,0 +1,184 @@
+package dhl
+
+import (
+	"bytes"
+	"context"
+	_ "embed"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+)
+
+const (
+	// zoneMapUpdated is the date the embedded postal zone CSV was last updated (quarterly)
+	zoneMapUpdated = "2026-10-01"
+
+	// zoneMapMaxAge is the age after which the embedded postal zone CSV is considered outdated
+	zoneMapMaxAge = 92 * 24 * time.Hour
+
+	// zoneMapCacheTTL is how long the on-disk zone map cache stays valid
+	zoneMapCacheTTL = 24 * time.Hour
+)
+
+//go:embed data/postal_zones.csv
+var postalZonesCSV []byte
+
+// ErrUnknownZone is returned when a postal code zone is not present in the zone map
+var ErrUnknownZone = errors.New("unknown postal code zone")
+
+// zoneMapCache holds the zone map of a client in memory, it expires together with the on-disk cache
+type zoneMapCache struct {
+	mu     sync.Mutex
+	zones  map[string]string
+	loaded time.Time
+}
+
+// GetPostalCodeZoneMap returns a mapping from 2-digit Polish postal code prefix to zone name
+// The map is loaded from the on-disk cache if it is younger than 24 hours,
+// otherwise from the embedded CSV file which is then written to the cache
+// DHL24 WebAPI has no zone map operation, so an outdated embedded file only produces a warning
+// The returned map is a copy and may be modified by the caller
+func (c *Client) GetPostalCodeZoneMap(ctx context.Context) (map[string]string, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	c.zoneMap.mu.Lock()
+	defer c.zoneMap.mu.Unlock()
+
+	if c.zoneMap.zones != nil && time.Since(c.zoneMap.loaded) <= zoneMapCacheTTL {
+		return maps.Clone(c.zoneMap.zones), nil
+	}
+
+	cachePath := zoneMapCachePath()
+	if zones, written, err := readZoneMapCache(cachePath); err == nil {
+		c.zoneMap.zones, c.zoneMap.loaded = zones, written
+		return maps.Clone(zones), nil
+	}
+
+	if zoneMapOutdated() {
+		c.logger.Warn("embedded postal zone map is outdated", "updated", zoneMapUpdated)
+	}
+
+	zones, err := parseZoneMapCSV(postalZonesCSV)
+	if err != nil {
+		return nil, err
+	}
+
+	writeZoneMapCache(cachePath, zones, c.logger)
+	c.zoneMap.zones, c.zoneMap.loaded = zones, time.Now()
+
+	return maps.Clone(zones), nil
+}
+
+// CheckPostalCode returns the zone name of a Polish postal code
+// The zone is looked up in the postal zone map without an API call; when the embedded map is outdated
+// or has no entry for the prefix, getPostalCodeServices is called to confirm that DHL24 serves the postal code
+// and the 2-digit prefix is returned for zones missing from the map
+// Returns ErrUnknownZone if the postal code is not served
+func (c *Client) CheckPostalCode(ctx context.Context, postalCode string) (string, error) {
+	zone := PostalCode(postalCode).Zone()
+	if zone == "" {
+		return "", fmt.Errorf("%w: %q", ErrUnknownZone, postalCode)
+	}
+
+	zones, err := c.GetPostalCodeZoneMap(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	name, ok := zones[zone]
+	if ok && !zoneMapOutdated() {
+		return name, nil
+	}
+
+	if _, _, err := c.getPostalCodeServices(ctx, postalCode, time.Now()); err != nil {
+		var fault *SOAPFault
+		if errors.As(err, &fault) && !errors.Is(err, ErrSessionExpired) && fault.FaultCode() != faultCodeInvalidCredentials {
+			return "", fmt.Errorf("%w: %q: %w", ErrUnknownZone, postalCode, err)
+		}
+		return "", err
+	}
+
+	if !ok {
+		name = zone
+	}
+	return name, nil
+}
+
+// zoneMapOutdated reports whether the embedded postal zone CSV is older than zoneMapMaxAge
+func zoneMapOutdated() bool {
+	updated, err := time.Parse("2006-01-02", zoneMapUpdated)
+	return err == nil && time.Since(updated) > zoneMapMaxAge
+}
+
+// zoneMapCachePath returns the on-disk location of the zone map cache
+func zoneMapCachePath() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "dhl24", "postal_zones.json")
+}
+
+// readZoneMapCache loads the zone map from disk if the cache file is fresh and returns when it was written
+func readZoneMapCache(path string) (map[string]string, time.Time, error) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+	if time.Since(info.ModTime()) > zoneMapCacheTTL {
+		return nil, time.Time{}, fmt.Errorf("zone map cache expired")
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	var zones map[string]string
+	if err := json.Unmarshal(data, &zones); err != nil {
+		return nil, time.Time{}, err
+	}
+	return zones, info.ModTime(), nil
+}
+
+// writeZoneMapCache stores the zone map on disk, failures are reported as warnings
+func writeZoneMapCache(path string, zones map[string]string, logger Logger) {
+	data, err := json.Marshal(zones)
+	if err != nil {
+		return
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		logger.Warn("failed to create zone map cache directory", "error", err)
+		return
+	}
+
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		logger.Warn("failed to write zone map cache", "path", path, "error", err)
+	}
+}
+
+// parseZoneMapCSV parses "prefix,zone" records, skipping the header row
+func parseZoneMapCSV(data []byte) (map[string]string, error) {
+	records, err := csv.NewReader(bytes.NewReader(data)).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing postal zone map: %w", err)
+	}
+
+	zones := make(map[string]string, len(records))
+	for i, record := range records {
+		if i == 0 || len(record) < 2 {
+			continue
+		}
+		zones[record[0]] = record[1]
+	}
+	return zones, nil
+}
@@ -0,0 +1,122 @@
+package dhl
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestGetPostalCodeZoneMapReturnsCopy(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	client := NewClient(&DHL24Config{})
+	zones, err := client.GetPostalCodeZoneMap(context.Background())
+	if err != nil {
+		t.Fatalf("GetPostalCodeZoneMap() error = %v", err)
+	}
+	zone, ok := zones["01"]
+	if !ok {
+		t.Fatal("zone 01 missing from the embedded map")
+	}
+	zones["01"] = "changed"
+
+	again, err := client.GetPostalCodeZoneMap(context.Background())
+	if err != nil {
+		t.Fatalf("GetPostalCodeZoneMap() error = %v", err)
+	}
+	if again["01"] != zone {
+		t.Errorf("cached map changed by the caller, zone 01 = %q", again["01"])
+	}
+}
+
+func TestGetPostalCodeZoneMapExpiresInMemoryCache(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	client := NewClient(&DHL24Config{})
+	client.zoneMap.zones = map[string]string{"01": "stale"}
+	client.zoneMap.loaded = time.Now().Add(-zoneMapCacheTTL - time.Minute)
+
+	zones, err := client.GetPostalCodeZoneMap(context.Background())
+	if err != nil {
+		t.Fatalf("GetPostalCodeZoneMap() error = %v", err)
+	}
+	if zones["01"] == "stale" || len(zones) < 2 {
+		t.Errorf("expired in-memory map returned: %v", zones)
+	}
+}
+
+func TestGetPostalCodeZoneMapIsPerClient(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	stale := NewClient(&DHL24Config{})
+	stale.zoneMap.zones = map[string]string{"01": "stale"}
+	stale.zoneMap.loaded = time.Now()
+
+	zones, err := NewClient(&DHL24Config{}).GetPostalCodeZoneMap(context.Background())
+	if err != nil {
+		t.Fatalf("GetPostalCodeZoneMap() error = %v", err)
+	}
+	if zones["01"] == "stale" {
+		t.Error("zone map cached by another client returned")
+	}
+}
+
+func TestCheckPostalCodeUsesZoneMap(t *testing.T) {
+	t.Setenv("XDG_CACHE_HOME", t.TempDir())
+	t.Setenv("HOME", t.TempDir())
+
+	var calls atomic.Int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls.Add(1)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL))
+	zone, err := client.CheckPostalCode(context.Background(), "01-249")
+	if err != nil {
+		t.Fatalf("CheckPostalCode() error = %v", err)
+	}
+	if zone != "Warszawa Śródmieście" {
+		t.Errorf("CheckPostalCode() = %q, want %q", zone, "Warszawa Śródmieście")
+	}
+	if calls.Load() != 0 {
+		t.Errorf("API called %d times, want 0", calls.Load())
+	}
+}
+
+func TestCheckPostalCodeFallsBackToAPI(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if strings.Contains(string(body), "<postCode>99300</postCode>") {
+			io.WriteString(w, `<Envelope><Body><getPostalCodeServicesResponse><getPostalCodeServicesResult><drPickupFrom>10:00</drPickupFrom><drPickupTo>15:00</drPickupTo></getPostalCodeServicesResult></getPostalCodeServicesResponse></Body></Envelope>`)
+			return
+		}
+		io.WriteString(w, `<Envelope><Body><Fault><faultcode>101</faultcode><faultstring>Invalid postal code</faultstring></Fault></Body></Envelope>`)
+	}))
+	defer server.Close()
+
+	client := NewClient(&DHL24Config{}, WithEndpoint(server.URL))
+	client.zoneMap.zones = map[string]string{"01": "Warszawa Śródmieście"}
+	client.zoneMap.loaded = time.Now()
+
+	zone, err := client.CheckPostalCode(context.Background(), "99-300")
+	if err != nil {
+		t.Fatalf("CheckPostalCode() error = %v", err)
+	}
+	if zone != "99" {
+		t.Errorf("CheckPostalCode() = %q, want %q", zone, "99")
+	}
+
+	if _, err := client.CheckPostalCode(context.Background(), "98-100"); !errors.Is(err, ErrUnknownZone) {
+		t.Errorf("CheckPostalCode() error = %v, want ErrUnknownZone", err)
+	}
+}
@@ -2,117 +2,314 @@ package main
 
 import (
 	"context"
+	"flag"
 	"fmt"
+	"net/http"
 	"os"
+	"os/signal"
 	"time"
 
 	"dhl-test/dhl"
+	"dhl-test/dhl/metrics"
 )
 
+// command parses the subcommand flags from args and returns the action to run
+// Flags are parsed before the config is loaded, so -h works without credentials
+type command func(args []string) action
+
+// action runs a parsed subcommand against the API
+type action func(ctx context.Context, client *dhl.Client, config *dhl.Config) error
+
+var commands = map[string]command{
+	"version":   runVersion,
+	"shipments": runShipments,
+	"create":    runCreate,
+	"track":     runTrack,
+	"cancel":    runCancel,
+	"label":     runLabel,
+}
+
+func usage() {
+	fmt.Fprintln(os.Stderr, "Usage: dhl-test [--config=path] <command> [flags]")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Commands:")
+	fmt.Fprintln(os.Stderr, "  version    show the DHL24 API version")
+	fmt.Fprintln(os.Stderr, "  shipments  list shipments (--from, --to, --offset, --format)")
+	fmt.Fprintln(os.Stderr, "  create     create a test shipment (--date, --content)")
+	fmt.Fprintln(os.Stderr, "  track      show tracking events (--id)")
+	fmt.Fprintln(os.Stderr, "  cancel     cancel a shipment (--id)")
+	fmt.Fprintln(os.Stderr, "  label      save a shipment label (--id, --out, --type)")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Run 'dhl-test <command> -h' for command flags.")
+	fmt.Fprintln(os.Stderr)
+	fmt.Fprintln(os.Stderr, "Global flags:")
+	flag.PrintDefaults()
+}
+
 func main() {
+	configPath := flag.String("config", "", "config file (.json, .yaml or .yml), defaults to DHL24_* environment variables or "+dhl.DefaultConfigPath)
+	metricsAddr := flag.String("metrics-addr", "", "serve Prometheus metrics on this address, e.g. :9090, until interrupted")
+	flag.Usage = usage
+	flag.Parse()
+
+	if flag.NArg() < 1 {
+		usage()
+		os.Exit(2)
+	}
+
+	cmd, ok := commands[flag.Arg(0)]
+	if !ok {
+		fmt.Fprintf(os.Stderr, "Unknown command %q\n\n", flag.Arg(0))
+		usage()
+		os.Exit(2)
+	}
+	run := cmd(flag.Args()[1:])
+
 	// Load configuration
-	config, err := dhl.LoadConfig()
+	var config *dhl.Config
+	var err error
+	if *configPath != "" {
+		config, err = dhl.LoadConfigFromFile(*configPath)
+	} else {
+		config, err = dhl.LoadConfigAuto()
+	}
 	if err != nil {
 		fmt.Printf("Error loading config: %v\n", err)
 		fmt.Println("\nPlease copy config.example.json to config.json and fill in your credentials.")
 		os.Exit(1)
 	}
 
-	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 	defer cancel()
 
 	// Create DHL client
-	client := dhl.NewClient(&config.DHL24)
+	var opts []dhl.Option
+	if *metricsAddr != "" {
+		opts = append(opts, metrics.WithPrometheusMetrics("dhl24"))
+		serveMetrics(*metricsAddr)
+	}
+	client := dhl.NewClient(&config.DHL24, opts...)
 
-	// Test getVersion method - check API version (no auth required)
-	testGetVersion(ctx, client)
+	err = run(ctx, client, config)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "Error:", err)
+	}
 
-	// Test createShipment method
-	// testCreateShipment(ctx, client, config)
+	if *metricsAddr != "" {
+		fmt.Fprintf(os.Stderr, "Serving metrics on %s/metrics, press Ctrl+C to exit\n", *metricsAddr)
+		interrupt := make(chan os.Signal, 1)
+		signal.Notify(interrupt, os.Interrupt)
+		<-interrupt
+	}
+	if err != nil {
+		os.Exit(1)
+	}
+}
 
-	// Test getMyShipments method - get shipments from last 7 days
-	testGetMyShipments(ctx, client)
+// serveMetrics starts an HTTP server exposing /metrics in the background
+func serveMetrics(addr string) {
+	mux := http.NewServeMux()
+	mux.Handle("/metrics", metrics.MetricsHandler())
+	go func() {
+		if err := http.ListenAndServe(addr, mux); err != nil {
+			fmt.Fprintln(os.Stderr, "Error serving metrics:", err)
+			os.Exit(1)
+		}
+	}()
 }
 
-func testGetVersion(ctx context.Context, client *dhl.Client) {
-	version, resp, err := client.GetVersion(ctx)
-	if err != nil {
-		fmt.Println("Error:", err)
-		return
+// parseShipmentIDFlag returns the shipment ID given with --id
+func parseShipmentIDFlag(id string) (dhl.ShipmentID, error) {
+	if id == "" {
+		return "", fmt.Errorf("--id is required")
+	}
+	return dhl.ParseShipmentID(id)
+}
+
+func runVersion(args []string) action {
+	fs := flag.NewFlagSet("version", flag.ExitOnError)
+	_ = fs.Parse(args)
+
+	return func(ctx context.Context, client *dhl.Client, _ *dhl.Config) error {
+		version, resp, err := client.GetVersion(ctx)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("=== getVersion ===")
+		fmt.Printf("HTTP status: %d (%d ms)\n", resp.StatusCode, resp.DurationMs)
+		fmt.Println("API Version:", version)
+		return nil
 	}
+}
+
+func runShipments(args []string) action {
+	today := time.Now()
+	fs := flag.NewFlagSet("shipments", flag.ExitOnError)
+	from := fs.String("from", today.AddDate(0, 0, -7).Format("2006-01-02"), "created from date, YYYY-MM-DD")
+	to := fs.String("to", today.Format("2006-01-02"), "created to date, YYYY-MM-DD")
+	offset := fs.Int("offset", 0, "number of shipments to skip")
+	format := fs.String("format", "table", "output format: json, csv or table")
+	_ = fs.Parse(args)
 
-	fmt.Println("=== getVersion ===")
-	fmt.Println("HTTP status:", resp.Status)
-	fmt.Println("API Version:", version)
-	fmt.Println()
+	return func(ctx context.Context, client *dhl.Client, _ *dhl.Config) error {
+		page, resp, err := client.GetMyShipmentsPageWithTotal(ctx, *from, *to, *offset)
+		if err != nil {
+			return err
+		}
+
+		list := dhl.ShipmentList(page.Items)
+		switch *format {
+		case "json":
+			return list.WriteJSON(os.Stdout, true)
+		case "csv":
+			return list.WriteCSV(os.Stdout)
+		case "table":
+			fmt.Printf("=== getMyShipments (%s - %s) ===\n", *from, *to)
+			fmt.Printf("HTTP status: %d (%d ms)\n", resp.StatusCode, resp.DurationMs)
+			fmt.Printf("Showing %d-%d of %d\n", page.Offset+1, page.Offset+len(page.Items), page.Total)
+			fmt.Println()
+			list.PrintTable(os.Stdout)
+			return nil
+		default:
+			return fmt.Errorf("unsupported format %q (use json, csv or table)", *format)
+		}
+	}
 }
 
-func testCreateShipment(ctx context.Context, client *dhl.Client, config *dhl.Config) {
-	// Build shipment from structs
-	shipment := dhl.ShipmentItem{
-		Shipper: dhl.Address{
-			Name:         "ESMALTE INC",
-			PostalCode:   "01249",
-			City:         "Warsaw",
-			Street:       "GOLESZOWSKA",
-			HouseNumber:  "3",
-			ContactPhone: "123456789",
-			ContactEmail: "sender@example.com",
-		},
-		Receiver: dhl.Address{
-			Country:      "PL",
-			Name:         "Test Receiver",
-			PostalCode:   "01249",
-			City:         "Warsaw",
-			Street:       "GOLESZOWSKA",
-			HouseNumber:  "3",
-			ContactPhone: "987654321",
-			ContactEmail: "receiver@example.com",
-		},
-		PieceList: dhl.PieceList{
-			Items: []dhl.Piece{
-				{
-					Type:     "ENVELOPE",
-					Quantity: 1,
-					Weight:   0.5,
+func runCreate(args []string) action {
+	fs := flag.NewFlagSet("create", flag.ExitOnError)
+	date := fs.String("date", time.Now().AddDate(0, 0, 1).Format("2006-01-02"), "shipment date, YYYY-MM-DD")
+	content := fs.String("content", "test content", "shipment content description")
+	_ = fs.Parse(args)
+
+	return func(ctx context.Context, client *dhl.Client, config *dhl.Config) error {
+		// Build shipment from structs
+		shipment := dhl.ShipmentRequest{
+			Shipper: dhl.Address{
+				Name:         "ESMALTE INC",
+				PostalCode:   "01249",
+				City:         "Warsaw",
+				Street:       "GOLESZOWSKA",
+				HouseNumber:  "3",
+				ContactPhone: "123456789",
+				ContactEmail: "sender@example.com",
+			},
+			Receiver: dhl.Address{
+				Country:      "PL",
+				Name:         "Test Receiver",
+				PostalCode:   "01249",
+				City:         "Warsaw",
+				Street:       "GOLESZOWSKA",
+				HouseNumber:  "3",
+				ContactPhone: "987654321",
+				ContactEmail: "receiver@example.com",
+			},
+			PieceList: dhl.PieceList{
+				Items: []dhl.Piece{
+					{
+						Type:     "ENVELOPE",
+						Quantity: 1,
+						Weight:   0.5,
+					},
 				},
 			},
-		},
-		Payment: dhl.Payment{
-			PaymentType:   "BANK_TRANSFER",
-			PayerType:     "SHIPPER",
-			AccountNumber: config.DHL24.AccountNumber,
-			PaymentMethod: "BANK_TRANSFER",
-		},
-		Service: dhl.Service{
-			Product: "AH",
-		},
-		ShipmentDate:         time.Now().AddDate(0, 0, 1).Format("2006-01-02"),
-		SkipRestrictionCheck: true,
-		Content:              "test content",
-	}
+			Payment: dhl.Payment{
+				PaymentType:   "BANK_TRANSFER",
+				PayerType:     dhl.PaymentTypeShipper,
+				AccountNumber: config.DHL24.AccountNumber,
+				PaymentMethod: "BANK_TRANSFER",
+			},
+			Service: dhl.Service{
+				Product: dhl.ProductParcel,
+			},
+			ShipmentDate:         *date,
+			SkipRestrictionCheck: true,
+			Content:              *content,
+		}
 
-	result, resp, err := client.CreateShipment(ctx, shipment)
-	if err != nil {
-		fmt.Println("Error:", err)
-		return
+		result, resp, err := client.CreateShipment(ctx, shipment)
+		if err != nil {
+			return err
+		}
+
+		fmt.Println("=== createShipment ===")
+		fmt.Printf("HTTP status: %d (%d ms)\n", resp.StatusCode, resp.DurationMs)
+		fmt.Printf("Created shipment ID: %s\n", result.ShipmentID)
+		return nil
 	}
+}
 
-	fmt.Println("=== createShipment ===")
-	fmt.Println("HTTP status:", resp.Status)
-	fmt.Printf("Created shipment ID: %s\n", result.ShipmentID)
+func runTrack(args []string) action {
+	fs := flag.NewFlagSet("track", flag.ExitOnError)
+	idFlag := fs.String("id", "", "shipment ID")
+	_ = fs.Parse(args)
+
+	return func(ctx context.Context, client *dhl.Client, _ *dhl.Config) error {
+		id, err := parseShipmentIDFlag(*idFlag)
+		if err != nil {
+			return err
+		}
+
+		events, _, err := client.GetTrackAndTrace(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("=== getTrackAndTraceInfo %s ===\n", id)
+		for _, event := range events {
+			fmt.Printf("%s | %-5s | %-20s | %s\n", event.Timestamp.Format("2006-01-02 15:04"), event.Status, event.Location, event.Description)
+		}
+		return nil
+	}
 }
 
-func testGetMyShipments(ctx context.Context, client *dhl.Client) {
-	shipments, resp, err := client.GetMyShipmentsLastDays(ctx, 7)
-	if err != nil {
-		fmt.Println("Error:", err)
-		return
+func runCancel(args []string) action {
+	fs := flag.NewFlagSet("cancel", flag.ExitOnError)
+	idFlag := fs.String("id", "", "shipment ID")
+	_ = fs.Parse(args)
+
+	return func(ctx context.Context, client *dhl.Client, _ *dhl.Config) error {
+		id, err := parseShipmentIDFlag(*idFlag)
+		if err != nil {
+			return err
+		}
+
+		if _, _, err := client.CancelShipment(ctx, id); err != nil {
+			return err
+		}
+
+		fmt.Printf("Cancelled shipment %s\n", id)
+		return nil
 	}
+}
+
+func runLabel(args []string) action {
+	fs := flag.NewFlagSet("label", flag.ExitOnError)
+	idFlag := fs.String("id", "", "shipment ID")
+	out := fs.String("out", "", "output file, defaults to <id>.pdf or <id>.zpl")
+	labelType := fs.String("type", string(dhl.LabelTypeBLP), "label type: LP, BLP or ZBLP")
+	_ = fs.Parse(args)
 
-	fmt.Println("=== getMyShipments (last 7 days) ===")
-	fmt.Println("HTTP status:", resp.Status)
-	fmt.Println()
+	return func(ctx context.Context, client *dhl.Client, _ *dhl.Config) error {
+		id, err := parseShipmentIDFlag(*idFlag)
+		if err != nil {
+			return err
+		}
 
-	dhl.PrintShipments(shipments)
+		path := *out
+		if path == "" {
+			path = string(id) + ".pdf"
+			if dhl.LabelType(*labelType) == dhl.LabelTypeZPL {
+				path = string(id) + ".zpl"
+			}
+		}
+
+		if err := client.SaveLabel(ctx, id, dhl.LabelType(*labelType), path); err != nil {
+			return err
+		}
+
+		fmt.Printf("Saved %s label of shipment %s to %s\n", *labelType, id, path)
+		return nil
+	}
 }